@@ -0,0 +1,49 @@
+package examples
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/fqjony/go-octopusdeploy/octopusdeploy"
+)
+
+func GetCurrentReleaseExample() {
+	var (
+		apiKey     string = "API-YOUR_API_KEY"
+		octopusURL string = "https://your_octopus_url"
+		spaceID    string = "space-id"
+
+		// dashboard values
+		environmentName string = "Production"
+		projectName     string = "project-name"
+	)
+
+	apiURL, err := url.Parse(octopusURL)
+	if err != nil {
+		_ = fmt.Errorf("error parsing URL for Octopus API: %v", err)
+		return
+	}
+
+	client, err := octopusdeploy.NewClient(nil, apiURL, apiKey, spaceID)
+	if err != nil {
+		_ = fmt.Errorf("error creating API client: %v", err)
+		return
+	}
+
+	// get dashboard
+	dashboard, err := client.Dashboards.Get(octopusdeploy.DashboardQuery{})
+	if err != nil {
+		_ = fmt.Errorf("error getting dashboard: %v", err)
+		return
+	}
+
+	// find the release currently deployed
+	matrix := octopusdeploy.NewDashboardMatrix(dashboard)
+	cell := matrix.GetByName(projectName, environmentName, "")
+	if cell == nil {
+		fmt.Printf("nothing deployed: (%s) to (%s)\n", projectName, environmentName)
+		return
+	}
+
+	fmt.Printf("current release: (%s) to (%s) is %s [%s]\n", projectName, environmentName, cell.ReleaseVersion, cell.State)
+}
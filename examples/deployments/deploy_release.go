@@ -0,0 +1,53 @@
+package examples
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/fqjony/go-octopusdeploy/octopusdeploy"
+)
+
+func DeployReleaseExample() {
+	var (
+		apiKey     string = "API-YOUR_API_KEY"
+		octopusURL string = "https://your_octopus_url"
+		spaceID    string = "space-id"
+
+		// deployment values
+		environmentID string   = "environment-id"
+		releaseID     string   = "release-id"
+		tenantTags    []string = []string{"Tenant type/Customer"}
+	)
+
+	apiURL, err := url.Parse(octopusURL)
+	if err != nil {
+		_ = fmt.Errorf("error parsing URL for Octopus API: %v", err)
+		return
+	}
+
+	client, err := octopusdeploy.NewClient(nil, apiURL, apiKey, spaceID)
+	if err != nil {
+		_ = fmt.Errorf("error creating API client: %v", err)
+		return
+	}
+
+	// deploy the release to every tenant with the tags and wait for the
+	// deployments to complete
+	result, err := client.DeployRelease(releaseID, []string{environmentID}, octopusdeploy.DeployReleaseOptions{
+		MaxConcurrentWaits: 20,
+		TenantTags:         tenantTags,
+		Timeout:            2 * time.Hour,
+		UseGuidedFailure:   true,
+	})
+	if result != nil {
+		_ = result.WriteSummary(os.Stdout)
+	}
+	if err != nil {
+		_ = fmt.Errorf("error deploying release: %v", err)
+		return
+	}
+
+	fmt.Printf("release deployed: (%s) %s\n", result.ReleaseID, result.Version)
+}
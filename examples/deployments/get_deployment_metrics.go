@@ -0,0 +1,53 @@
+package examples
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/fqjony/go-octopusdeploy/octopusdeploy"
+)
+
+func GetDeploymentMetricsExample() {
+	var (
+		apiKey     string = "API-YOUR_API_KEY"
+		octopusURL string = "https://your_octopus_url"
+		spaceID    string = "space-id"
+
+		// deployment metrics values
+		days                    int    = 30
+		productionEnvironmentID string = "production-environment-id"
+		projectID               string = "project-id"
+	)
+
+	apiURL, err := url.Parse(octopusURL)
+	if err != nil {
+		_ = fmt.Errorf("error parsing URL for Octopus API: %v", err)
+		return
+	}
+
+	client, err := octopusdeploy.NewClient(nil, apiURL, apiKey, spaceID)
+	if err != nil {
+		_ = fmt.Errorf("error creating API client: %v", err)
+		return
+	}
+
+	// compute deployment metrics
+	metrics, err := client.GetDeploymentMetrics(octopusdeploy.DeploymentMetricsQuery{
+		From:                     time.Now().AddDate(0, 0, -days),
+		ProductionEnvironmentIDs: []string{productionEnvironmentID},
+		ProjectIDs:               []string{projectID},
+		To:                       time.Now(),
+	})
+	if err != nil {
+		_ = fmt.Errorf("error computing deployment metrics: %v", err)
+		return
+	}
+
+	fmt.Printf("change failure rate: %.0f%%, lead time: %s, time to restore: %s\n", metrics.ChangeFailureRate.Rate*100, metrics.LeadTimeForChanges.Median, metrics.MeanTimeToRestore.Mean)
+
+	if err := metrics.WriteJSON(os.Stdout); err != nil {
+		_ = fmt.Errorf("error writing deployment metrics: %v", err)
+	}
+}
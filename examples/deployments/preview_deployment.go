@@ -0,0 +1,64 @@
+package examples
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/fqjony/go-octopusdeploy/octopusdeploy"
+)
+
+func PreviewDeploymentExample() {
+	var (
+		apiKey     string = "API-YOUR_API_KEY"
+		octopusURL string = "https://your_octopus_url"
+		spaceID    string = "space-id"
+
+		// preview values
+		environmentID string = "environment-id"
+		releaseID     string = "release-id"
+	)
+
+	apiURL, err := url.Parse(octopusURL)
+	if err != nil {
+		_ = fmt.Errorf("error parsing URL for Octopus API: %v", err)
+		return
+	}
+
+	client, err := octopusdeploy.NewClient(nil, apiURL, apiKey, spaceID)
+	if err != nil {
+		_ = fmt.Errorf("error creating API client: %v", err)
+		return
+	}
+
+	release, err := client.Releases.GetByID(releaseID)
+	if err != nil {
+		_ = fmt.Errorf("error getting release: %v", err)
+		return
+	}
+
+	environment, err := client.Environments.GetByID(environmentID)
+	if err != nil {
+		_ = fmt.Errorf("error getting environment: %v", err)
+		return
+	}
+
+	// pass a tenant instead of nil to preview a tenanted deployment
+	preview, err := client.Releases.GetDeploymentPreview(release, environment, nil)
+	if err != nil {
+		_ = fmt.Errorf("error getting deployment preview: %v", err)
+		return
+	}
+
+	for _, step := range preview.StepsToExclude {
+		fmt.Printf("step %s: %s (%s)\n", step.ActionNumber, step.ActionName, strings.Join(step.MachineNames, ", "))
+	}
+
+	// check the prompted variables before deploying
+	deployment := octopusdeploy.NewDeployment("", environmentID, releaseID)
+	deployment.FormValues = map[string]string{}
+	if err := deployment.ValidateFormValues(preview.Form); err != nil {
+		_ = fmt.Errorf("error validating prompted variables: %v", err)
+		return
+	}
+}
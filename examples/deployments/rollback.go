@@ -0,0 +1,55 @@
+package examples
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/fqjony/go-octopusdeploy/octopusdeploy"
+)
+
+func RollbackExample() {
+	var (
+		apiKey     string = "API-YOUR_API_KEY"
+		octopusURL string = "https://your_octopus_url"
+		spaceID    string = "space-id"
+
+		// rollback values
+		environmentID string = "environment-id"
+		projectID     string = "project-id"
+		tenantID      string = ""
+	)
+
+	apiURL, err := url.Parse(octopusURL)
+	if err != nil {
+		_ = fmt.Errorf("error parsing URL for Octopus API: %v", err)
+		return
+	}
+
+	client, err := octopusdeploy.NewClient(nil, apiURL, apiKey, spaceID)
+	if err != nil {
+		_ = fmt.Errorf("error creating API client: %v", err)
+		return
+	}
+
+	// redeploy the last known-good release with its original form values
+	// and skipped steps
+	rollback, err := client.Rollback(projectID, environmentID, tenantID, octopusdeploy.RollbackOptions{
+		ReuseFormValues:  true,
+		ReuseSkipActions: true,
+	})
+	if err != nil {
+		_ = fmt.Errorf("error rolling back: %v", err)
+		return
+	}
+
+	fmt.Printf("rolling back from %s to %s\n", rollback.FromRelease.Version, rollback.ToRelease.Version)
+
+	task, err := client.WaitForTask(rollback.Deployment.TaskID, time.Hour)
+	if err != nil {
+		_ = fmt.Errorf("error waiting for rollback: %v", err)
+		return
+	}
+
+	fmt.Printf("rollback completed: (%s) %s\n", task.GetID(), task.State)
+}
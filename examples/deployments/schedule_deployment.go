@@ -0,0 +1,71 @@
+package examples
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/fqjony/go-octopusdeploy/octopusdeploy"
+)
+
+func ScheduleDeploymentExample() {
+	var (
+		apiKey     string = "API-YOUR_API_KEY"
+		octopusURL string = "https://your_octopus_url"
+		spaceID    string = "space-id"
+
+		// deployment values
+		environmentID string = "environment-id"
+		releaseID     string = "release-id"
+
+		// maintenance window
+		queueTime       time.Time = time.Date(2026, 10, 24, 22, 0, 0, 0, time.UTC)
+		queueTimeExpiry time.Time = queueTime.Add(2 * time.Hour)
+	)
+
+	apiURL, err := url.Parse(octopusURL)
+	if err != nil {
+		_ = fmt.Errorf("error parsing URL for Octopus API: %v", err)
+		return
+	}
+
+	client, err := octopusdeploy.NewClient(nil, apiURL, apiKey, spaceID)
+	if err != nil {
+		_ = fmt.Errorf("error creating API client: %v", err)
+		return
+	}
+
+	deployment := octopusdeploy.NewDeployment("", environmentID, releaseID)
+	deployment, err = client.ScheduleDeployment(deployment, queueTime, queueTimeExpiry)
+	if err != nil {
+		_ = fmt.Errorf("error scheduling deployment: %v", err)
+		return
+	}
+
+	fmt.Printf("deployment scheduled: (%s) %s\n", deployment.GetID(), deployment.TaskID)
+
+	// warn about deployments and runbook runs scheduled in overlapping windows
+	conflicts, err := client.CheckScheduleConflicts([]string{environmentID}, queueTime.Add(-24*time.Hour), queueTimeExpiry)
+	if err != nil {
+		_ = fmt.Errorf("error checking schedule conflicts: %v", err)
+		return
+	}
+
+	for _, conflict := range conflicts {
+		fmt.Printf("warning: %v\n", conflict)
+	}
+
+	// move the deployments and runbook runs queued in the window to the next day
+	queuedTasks, err := client.GetQueuedTasks([]string{environmentID}, queueTime, queueTimeExpiry)
+	if err != nil {
+		_ = fmt.Errorf("error getting queued tasks: %v", err)
+		return
+	}
+
+	for _, queuedTask := range queuedTasks {
+		if _, err := client.RescheduleQueuedTask(queuedTask, queueTime.AddDate(0, 0, 1), queueTimeExpiry.AddDate(0, 0, 1)); err != nil {
+			_ = fmt.Errorf("error rescheduling queued task: %v", err)
+			return
+		}
+	}
+}
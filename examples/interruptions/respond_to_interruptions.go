@@ -0,0 +1,51 @@
+package examples
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/fqjony/go-octopusdeploy/octopusdeploy"
+)
+
+func RespondToInterruptionsExample() {
+	var (
+		apiKey     string = "API-YOUR_API_KEY"
+		octopusURL string = "https://your_octopus_url"
+		spaceID    string = "space-id"
+
+		// interruption values
+		taskID string = "task-id"
+	)
+
+	apiURL, err := url.Parse(octopusURL)
+	if err != nil {
+		_ = fmt.Errorf("error parsing URL for Octopus API: %v", err)
+		return
+	}
+
+	client, err := octopusdeploy.NewClient(nil, apiURL, apiKey, spaceID)
+	if err != nil {
+		_ = fmt.Errorf("error creating API client: %v", err)
+		return
+	}
+
+	// retry failed steps up to 2 times, then fail the deployment
+	responder := octopusdeploy.NewInterruptionResponder(client, octopusdeploy.InterruptionPolicy{
+		GuidedFailureGuidance: octopusdeploy.GuidedFailureGuidanceFail,
+		MaximumRetries:        2,
+		Notes:                 "Responded by the deployment pipeline",
+	})
+
+	task, responses, err := responder.Watch(taskID, time.Hour)
+	if err != nil {
+		_ = fmt.Errorf("error responding to interruptions: %v", err)
+		return
+	}
+
+	for _, response := range responses {
+		fmt.Printf("interruption: (%s) %s %s%s\n", response.InterruptionID, response.Title, response.Guidance, response.Result)
+	}
+
+	fmt.Printf("task completed: (%s) %s\n", task.GetID(), task.State)
+}
@@ -0,0 +1,83 @@
+package examples
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/fqjony/go-octopusdeploy/octopusdeploy"
+)
+
+func SimulateLifecycleExample() {
+	var (
+		apiKey     string = "API-YOUR_API_KEY"
+		octopusURL string = "https://your_octopus_url"
+		spaceID    string = "space-id"
+
+		// simulation values
+		lifecycleID string = "lifecycle-id"
+		projectID   string = "project-id"
+	)
+
+	apiURL, err := url.Parse(octopusURL)
+	if err != nil {
+		_ = fmt.Errorf("error parsing URL for Octopus API: %v", err)
+		return
+	}
+
+	client, err := octopusdeploy.NewClient(nil, apiURL, apiKey, spaceID)
+	if err != nil {
+		_ = fmt.Errorf("error creating API client: %v", err)
+		return
+	}
+
+	lifecycle, err := client.Lifecycles.GetByID(lifecycleID)
+	if err != nil {
+		_ = fmt.Errorf("error getting lifecycle: %v", err)
+		return
+	}
+
+	environments, err := client.Environments.GetAll()
+	if err != nil {
+		_ = fmt.Errorf("error getting environments: %v", err)
+		return
+	}
+
+	project, err := client.Projects.GetByID(projectID)
+	if err != nil {
+		_ = fmt.Errorf("error getting project: %v", err)
+		return
+	}
+
+	progression, err := client.Projects.GetProgression(project)
+	if err != nil {
+		_ = fmt.Errorf("error getting progression: %v", err)
+		return
+	}
+
+	// change the lifecycle locally before it is saved
+	lifecycle.ReleaseRetentionPolicy = octopusdeploy.RetentionPeriod{
+		QuantityToKeep: 10,
+		Unit:           octopusdeploy.RetentionUnitItems,
+	}
+
+	simulator := octopusdeploy.NewLifecycleSimulator(lifecycle, environments)
+
+	releases := []*octopusdeploy.Release{}
+	current := []octopusdeploy.DashboardItem{}
+	for _, releaseProgression := range progression.Releases {
+		deployments := []octopusdeploy.DashboardItem{}
+		for _, items := range releaseProgression.Deployments {
+			deployments = append(deployments, items...)
+		}
+		current = append(current, deployments...)
+		releases = append(releases, releaseProgression.Release)
+
+		simulation := simulator.Simulate(deployments)
+		fmt.Printf("release %s can be deployed to %v (automatically to %v)\n", releaseProgression.Release.Version, simulation.NextEnvironmentIDs, simulation.AutomaticEnvironmentIDs)
+	}
+
+	for _, release := range simulator.GetReleasesToDelete(releases, current, time.Now()) {
+		fmt.Printf("release %s would be deleted by retention\n", release.Version)
+	}
+}
@@ -0,0 +1,42 @@
+package examples
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/fqjony/go-octopusdeploy/octopusdeploy"
+)
+
+func DrainOctopusServerNodeExample() {
+	var (
+		apiKey     string = "API-YOUR_API_KEY"
+		octopusURL string = "https://your_octopus_url"
+		spaceID    string = "space-id"
+
+		// octopus server node values
+		octopusServerNodeName string        = "octopus-server-node-name"
+		timeout               time.Duration = 30 * time.Minute
+	)
+
+	apiURL, err := url.Parse(octopusURL)
+	if err != nil {
+		_ = fmt.Errorf("error parsing URL for Octopus API: %v", err)
+		return
+	}
+
+	client, err := octopusdeploy.NewClient(nil, apiURL, apiKey, spaceID)
+	if err != nil {
+		_ = fmt.Errorf("error creating API client: %v", err)
+		return
+	}
+
+	// drain octopus server node
+	result, err := client.DrainNode(octopusServerNodeName, timeout)
+	if err != nil {
+		_ = fmt.Errorf("error draining octopus server node: %v", err)
+		return
+	}
+
+	fmt.Printf("octopus server node drained: (%s) in %s\n", result.Node.Name, result.Elapsed)
+}
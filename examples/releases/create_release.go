@@ -0,0 +1,61 @@
+package examples
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/fqjony/go-octopusdeploy/octopusdeploy"
+)
+
+func CreateReleaseExample() {
+	var (
+		apiKey     string = "API-YOUR_API_KEY"
+		octopusURL string = "https://your_octopus_url"
+		spaceID    string = "space-id"
+
+		// release values
+		channelID string = "channel-id"
+		projectID string = "project-id"
+	)
+
+	apiURL, err := url.Parse(octopusURL)
+	if err != nil {
+		_ = fmt.Errorf("error parsing URL for Octopus API: %v", err)
+		return
+	}
+
+	client, err := octopusdeploy.NewClient(nil, apiURL, apiKey, spaceID)
+	if err != nil {
+		_ = fmt.Errorf("error creating API client: %v", err)
+		return
+	}
+
+	options := octopusdeploy.CreateReleaseOptions{
+		PackageVersions: map[string]string{"Deploy web": "1.2.3"},
+		ReleaseNotes:    "Release notes",
+	}
+
+	// preview the package versions that would be selected
+	options.DryRun = true
+	plan, err := client.CreateRelease(projectID, channelID, options)
+	if err != nil {
+		_ = fmt.Errorf("error planning release: %v", err)
+		return
+	}
+
+	if err := plan.WriteJSON(os.Stdout); err != nil {
+		_ = fmt.Errorf("error writing release plan: %v", err)
+		return
+	}
+
+	// create the release
+	options.DryRun = false
+	plan, err = client.CreateRelease(projectID, channelID, options)
+	if err != nil {
+		_ = fmt.Errorf("error creating release: %v", err)
+		return
+	}
+
+	fmt.Printf("release created: (%s) %s\n", plan.Release.GetID(), plan.Version)
+}
@@ -0,0 +1,48 @@
+package examples
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/fqjony/go-octopusdeploy/octopusdeploy"
+)
+
+func GenerateReleaseNotesExample() {
+	var (
+		apiKey     string = "API-YOUR_API_KEY"
+		octopusURL string = "https://your_octopus_url"
+		spaceID    string = "space-id"
+
+		// release notes values
+		fromVersion string = "1.0.0"
+		projectID   string = "project-id"
+		toVersion   string = "1.1.0"
+	)
+
+	apiURL, err := url.Parse(octopusURL)
+	if err != nil {
+		_ = fmt.Errorf("error parsing URL for Octopus API: %v", err)
+		return
+	}
+
+	client, err := octopusdeploy.NewClient(nil, apiURL, apiKey, spaceID)
+	if err != nil {
+		_ = fmt.Errorf("error creating API client: %v", err)
+		return
+	}
+
+	// render the release notes with the default Markdown template
+	releaseNotes, err := client.GenerateReleaseNotes(projectID, fromVersion, toVersion, "")
+	if err != nil {
+		_ = fmt.Errorf("error generating release notes: %v", err)
+		return
+	}
+
+	fmt.Println(releaseNotes.Text)
+
+	// write the release notes as JSON for a release portal
+	if err := releaseNotes.WriteJSON(os.Stdout); err != nil {
+		_ = fmt.Errorf("error writing release notes: %v", err)
+	}
+}
@@ -0,0 +1,74 @@
+package examples
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/fqjony/go-octopusdeploy/octopusdeploy"
+)
+
+func GetReleaseByVersionExample() {
+	var (
+		apiKey     string = "API-YOUR_API_KEY"
+		octopusURL string = "https://your_octopus_url"
+		spaceID    string = "space-id"
+
+		// release values
+		channelID     string = "channel-id"
+		environmentID string = "environment-id"
+		projectID     string = "project-id"
+		version       string = "1.0.0"
+	)
+
+	apiURL, err := url.Parse(octopusURL)
+	if err != nil {
+		_ = fmt.Errorf("error parsing URL for Octopus API: %v", err)
+		return
+	}
+
+	client, err := octopusdeploy.NewClient(nil, apiURL, apiKey, spaceID)
+	if err != nil {
+		_ = fmt.Errorf("error creating API client: %v", err)
+		return
+	}
+
+	project, err := client.Projects.GetByID(projectID)
+	if err != nil {
+		_ = fmt.Errorf("error getting project: %v", err)
+		return
+	}
+
+	release, err := client.Releases.GetByProjectAndVersion(project, version)
+	if err != nil {
+		_ = fmt.Errorf("error getting release: %v", err)
+		return
+	}
+
+	fmt.Printf("release: (%s) %s\n", release.GetID(), release.Version)
+
+	// get the deployments of the release to an environment
+	deployments, err := client.Releases.GetDeploymentsOfRelease(release, []string{environmentID}, nil)
+	if err != nil {
+		_ = fmt.Errorf("error getting deployments of release: %v", err)
+		return
+	}
+
+	for _, deployment := range deployments {
+		fmt.Printf("deployment: (%s) %s\n", deployment.GetID(), deployment.TaskID)
+	}
+
+	// get the release of the channel with the highest version
+	channel, err := client.Channels.GetByID(channelID)
+	if err != nil {
+		_ = fmt.Errorf("error getting channel: %v", err)
+		return
+	}
+
+	latest, err := client.Releases.GetLatestForChannel(channel)
+	if err != nil {
+		_ = fmt.Errorf("error getting latest release: %v", err)
+		return
+	}
+
+	fmt.Printf("latest release: (%s) %s\n", latest.GetID(), latest.Version)
+}
@@ -0,0 +1,47 @@
+package examples
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/fqjony/go-octopusdeploy/octopusdeploy"
+)
+
+func PromoteReleaseExample() {
+	var (
+		apiKey     string = "API-YOUR_API_KEY"
+		octopusURL string = "https://your_octopus_url"
+		spaceID    string = "space-id"
+
+		// promotion values
+		fromEnvironmentID string = "from-environment-id"
+		projectID         string = "project-id"
+		toEnvironmentID   string = "to-environment-id"
+	)
+
+	apiURL, err := url.Parse(octopusURL)
+	if err != nil {
+		_ = fmt.Errorf("error parsing URL for Octopus API: %v", err)
+		return
+	}
+
+	client, err := octopusdeploy.NewClient(nil, apiURL, apiKey, spaceID)
+	if err != nil {
+		_ = fmt.Errorf("error creating API client: %v", err)
+		return
+	}
+
+	// promote the release deployed to the source environment
+	result, err := client.Promote(projectID, fromEnvironmentID, toEnvironmentID, octopusdeploy.DeployReleaseOptions{})
+	if errors.Is(err, octopusdeploy.ErrPromotionBlocked) {
+		fmt.Printf("promotion blocked: %v\n", err)
+		return
+	}
+	if err != nil {
+		_ = fmt.Errorf("error promoting release: %v", err)
+		return
+	}
+
+	fmt.Printf("release promoted: (%s) %s\n", result.ReleaseID, result.Version)
+}
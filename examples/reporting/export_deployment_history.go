@@ -0,0 +1,65 @@
+package examples
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/fqjony/go-octopusdeploy/octopusdeploy"
+)
+
+func ExportDeploymentHistoryExample() {
+	var (
+		apiKey     string = "API-YOUR_API_KEY"
+		octopusURL string = "https://your_octopus_url"
+		spaceID    string = "space-id"
+
+		// reporting values
+		fileName   string   = "deployments.csv"
+		from       string   = "2020-04-01T00:00:00Z"
+		projectIDs []string = []string{"project-id"}
+		to         string   = "2020-06-30T23:59:59Z"
+	)
+
+	apiURL, err := url.Parse(octopusURL)
+	if err != nil {
+		_ = fmt.Errorf("error parsing URL for Octopus API: %v", err)
+		return
+	}
+
+	client, err := octopusdeploy.NewClient(nil, apiURL, apiKey, spaceID)
+	if err != nil {
+		_ = fmt.Errorf("error creating API client: %v", err)
+		return
+	}
+
+	fromCompletedTime, _ := time.Parse(time.RFC3339, from)
+	toCompletedTime, _ := time.Parse(time.RFC3339, to)
+
+	// get deployment history
+	history, err := client.Reporting.GetDeploymentHistory(octopusdeploy.DeploymentHistoryQuery{
+		FromCompletedTime: &fromCompletedTime,
+		ProjectIDs:        projectIDs,
+		ToCompletedTime:   &toCompletedTime,
+	})
+	if err != nil {
+		_ = fmt.Errorf("error getting deployment history: %v", err)
+		return
+	}
+
+	file, err := os.Create(fileName)
+	if err != nil {
+		_ = fmt.Errorf("error creating file: %v", err)
+		return
+	}
+	defer file.Close()
+
+	// export deployment history
+	if err := history.WriteCSV(file); err != nil {
+		_ = fmt.Errorf("error exporting deployment history: %v", err)
+		return
+	}
+
+	fmt.Printf("deployments exported: %d to (%s)\n", history.CountByWeek().TotalCount, fileName)
+}
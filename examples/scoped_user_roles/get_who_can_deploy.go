@@ -0,0 +1,42 @@
+package examples
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/fqjony/go-octopusdeploy/octopusdeploy"
+)
+
+func GetWhoCanDeployExample() {
+	var (
+		apiKey     string = "API-YOUR_API_KEY"
+		octopusURL string = "https://your_octopus_url"
+		spaceID    string = "space-id"
+
+		// report values
+		environmentID string = "environment-id"
+	)
+
+	apiURL, err := url.Parse(octopusURL)
+	if err != nil {
+		_ = fmt.Errorf("error parsing URL for Octopus API: %v", err)
+		return
+	}
+
+	client, err := octopusdeploy.NewClient(nil, apiURL, apiKey, spaceID)
+	if err != nil {
+		_ = fmt.Errorf("error creating API client: %v", err)
+		return
+	}
+
+	report, err := client.GetWhoCanDeploy(spaceID, environmentID)
+	if err != nil {
+		_ = fmt.Errorf("error getting permission report: %v", err)
+		return
+	}
+
+	if err := report.WriteCSV(os.Stdout); err != nil {
+		_ = fmt.Errorf("error writing permission report: %v", err)
+	}
+}
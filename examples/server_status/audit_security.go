@@ -0,0 +1,43 @@
+package examples
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/fqjony/go-octopusdeploy/octopusdeploy"
+)
+
+func AuditSecurityExample() {
+	var (
+		apiKey     string = "API-YOUR_API_KEY"
+		octopusURL string = "https://your_octopus_url"
+		spaceID    string = "space-id"
+	)
+
+	apiURL, err := url.Parse(octopusURL)
+	if err != nil {
+		_ = fmt.Errorf("error parsing URL for Octopus API: %v", err)
+		return
+	}
+
+	client, err := octopusdeploy.NewClient(nil, apiURL, apiKey, spaceID)
+	if err != nil {
+		_ = fmt.Errorf("error creating API client: %v", err)
+		return
+	}
+
+	audit, err := client.AuditSecurity(octopusdeploy.SecurityAuditOptions{
+		APIKeyMaxAge:            90 * 24 * time.Hour,
+		CertificateExpiryWindow: 30 * 24 * time.Hour,
+	})
+	if err != nil {
+		_ = fmt.Errorf("error auditing security: %v", err)
+		return
+	}
+
+	if err := audit.WriteMarkdown(os.Stdout); err != nil {
+		_ = fmt.Errorf("error writing security audit: %v", err)
+	}
+}
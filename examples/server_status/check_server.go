@@ -0,0 +1,37 @@
+package examples
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/fqjony/go-octopusdeploy/octopusdeploy"
+)
+
+func CheckServerExample() {
+	var (
+		apiKey     string = "API-YOUR_API_KEY"
+		octopusURL string = "https://your_octopus_url"
+		spaceID    string = "space-id"
+	)
+
+	apiURL, err := url.Parse(octopusURL)
+	if err != nil {
+		_ = fmt.Errorf("error parsing URL for Octopus API: %v", err)
+		os.Exit(2)
+	}
+
+	client, err := octopusdeploy.NewClient(nil, apiURL, apiKey, spaceID)
+	if err != nil {
+		_ = fmt.Errorf("error creating API client: %v", err)
+		os.Exit(2)
+	}
+
+	// check server health, status and license
+	check := client.Check()
+	if err := check.WriteJSON(os.Stdout); err != nil {
+		_ = fmt.Errorf("error writing server check: %v", err)
+	}
+
+	os.Exit(check.ExitCode())
+}
@@ -0,0 +1,49 @@
+package examples
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/fqjony/go-octopusdeploy/octopusdeploy"
+)
+
+func GetServerVersionExample() {
+	var (
+		apiKey     string = "API-YOUR_API_KEY"
+		octopusURL string = "https://your_octopus_url"
+		spaceID    string = "space-id"
+	)
+
+	apiURL, err := url.Parse(octopusURL)
+	if err != nil {
+		_ = fmt.Errorf("error parsing URL for Octopus API: %v", err)
+		return
+	}
+
+	client, err := octopusdeploy.NewClient(nil, apiURL, apiKey, spaceID)
+	if err != nil {
+		_ = fmt.Errorf("error creating API client: %v", err)
+		return
+	}
+
+	fmt.Printf("server version: %s (API %s)\n", client.ServerVersion(), client.APIVersion())
+
+	// check for runbooks before using them
+	if !client.Supports(octopusdeploy.CapabilityRunbooks) {
+		fmt.Println("runbooks are not supported by this server")
+		return
+	}
+
+	runbooks, err := client.Runbooks.GetAll()
+	if errors.Is(err, octopusdeploy.ErrUnsupportedByServer) {
+		_ = fmt.Errorf("runbooks are not supported: %v", err)
+		return
+	}
+	if err != nil {
+		_ = fmt.Errorf("error getting runbooks: %v", err)
+		return
+	}
+
+	fmt.Printf("runbooks found: %d\n", len(runbooks))
+}
@@ -0,0 +1,57 @@
+package examples
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/fqjony/go-octopusdeploy/octopusdeploy"
+)
+
+func SyncTeamMembershipsExample() {
+	var (
+		apiKey     string = "API-YOUR_API_KEY"
+		octopusURL string = "https://your_octopus_url"
+		spaceID    string = "space-id"
+
+		// sync values
+		dryRun   bool   = true
+		fileName string = "/path/to/team-memberships.json"
+	)
+
+	apiURL, err := url.Parse(octopusURL)
+	if err != nil {
+		_ = fmt.Errorf("error parsing URL for Octopus API: %v", err)
+		return
+	}
+
+	client, err := octopusdeploy.NewClient(nil, apiURL, apiKey, spaceID)
+	if err != nil {
+		_ = fmt.Errorf("error creating API client: %v", err)
+		return
+	}
+
+	file, err := os.Open(fileName)
+	if err != nil {
+		_ = fmt.Errorf("error opening file: %v", err)
+		return
+	}
+	defer file.Close()
+
+	mappings, err := octopusdeploy.ParseTeamMembershipMappings(file)
+	if err != nil {
+		_ = fmt.Errorf("error reading team memberships: %v", err)
+		return
+	}
+
+	// compare teams against the mapping and apply the differences
+	sync, err := client.SyncTeamMemberships(mappings, dryRun)
+	if err != nil {
+		_ = fmt.Errorf("error synchronizing team memberships: %v", err)
+		return
+	}
+
+	if err := sync.WriteDiff(os.Stdout); err != nil {
+		_ = fmt.Errorf("error writing changes: %v", err)
+	}
+}
@@ -0,0 +1,55 @@
+package examples
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/fqjony/go-octopusdeploy/octopusdeploy"
+)
+
+func ImportUsersExample() {
+	var (
+		apiKey     string = "API-YOUR_API_KEY"
+		octopusURL string = "https://your_octopus_url"
+		spaceID    string = "space-id"
+
+		// import values
+		fileName string = "/path/to/users.csv"
+	)
+
+	apiURL, err := url.Parse(octopusURL)
+	if err != nil {
+		_ = fmt.Errorf("error parsing URL for Octopus API: %v", err)
+		return
+	}
+
+	client, err := octopusdeploy.NewClient(nil, apiURL, apiKey, spaceID)
+	if err != nil {
+		_ = fmt.Errorf("error creating API client: %v", err)
+		return
+	}
+
+	file, err := os.Open(fileName)
+	if err != nil {
+		_ = fmt.Errorf("error opening file: %v", err)
+		return
+	}
+	defer file.Close()
+
+	// read users from CSV
+	records, err := octopusdeploy.ParseUserImportCSV(file)
+	if err != nil {
+		_ = fmt.Errorf("error reading users: %v", err)
+		return
+	}
+
+	// create or update users and add them to their teams
+	result, err := client.ImportUsers(records)
+	if err != nil {
+		_ = fmt.Errorf("error importing users: %v", err)
+		return
+	}
+
+	fmt.Printf("users imported: %d created, %d updated, %d skipped, %d failed\n", len(result.Created), len(result.Updated), len(result.Skipped), len(result.Failed))
+}
@@ -0,0 +1,46 @@
+package examples
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/fqjony/go-octopusdeploy/octopusdeploy"
+)
+
+func ProvisionServiceAccountExample() {
+	var (
+		apiKey     string = "API-YOUR_API_KEY"
+		octopusURL string = "https://your_octopus_url"
+		spaceID    string = "space-id"
+
+		// service account values
+		name    string   = "build-server"
+		purpose string   = "continuous-integration"
+		teams   []string = []string{"Build Servers"}
+	)
+
+	apiURL, err := url.Parse(octopusURL)
+	if err != nil {
+		_ = fmt.Errorf("error parsing URL for Octopus API: %v", err)
+		return
+	}
+
+	client, err := octopusdeploy.NewClient(nil, apiURL, apiKey, spaceID)
+	if err != nil {
+		_ = fmt.Errorf("error creating API client: %v", err)
+		return
+	}
+
+	provisioning, err := client.ProvisionServiceAccount(name, teams, purpose)
+	if err != nil {
+		_ = fmt.Errorf("error provisioning service account: %v", err)
+		return
+	}
+
+	if provisioning.APIKey != nil {
+		// the API key must be stored now; it cannot be retrieved later
+		fmt.Printf("API key created: (%s)\n", provisioning.APIKey.GetID())
+	}
+
+	fmt.Printf("service account provisioned: (%s)\n", provisioning.User.GetID())
+}
@@ -0,0 +1,62 @@
+package examples
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"time"
+
+	"github.com/fqjony/go-octopusdeploy/octopusdeploy"
+)
+
+func RotateAPIKeyExample() {
+	var (
+		apiKey     string = "API-YOUR_API_KEY"
+		octopusURL string = "https://your_octopus_url"
+		spaceID    string = "space-id"
+
+		// API key values
+		maxAge  time.Duration = 90 * 24 * time.Hour
+		purpose string        = "continuous-integration"
+		secret  string        = "/path/to/secret"
+	)
+
+	apiURL, err := url.Parse(octopusURL)
+	if err != nil {
+		_ = fmt.Errorf("error parsing URL for Octopus API: %v", err)
+		return
+	}
+
+	client, err := octopusdeploy.NewClient(nil, apiURL, apiKey, spaceID)
+	if err != nil {
+		_ = fmt.Errorf("error creating API client: %v", err)
+		return
+	}
+
+	// find API keys older than the rotation policy allows
+	report, err := client.GetAPIKeysOlderThan(maxAge)
+	if err != nil {
+		_ = fmt.Errorf("error getting API keys: %v", err)
+		return
+	}
+
+	for _, item := range report.Items {
+		if !item.IsService || item.Purpose != purpose {
+			continue
+		}
+
+		// rotate API key and store the new key before the old one is revoked
+		rotation, err := client.APIKeys.Rotate(item.UserID, item.APIKeyID, octopusdeploy.APIKeyRotationOptions{
+			Sink: func(newAPIKey *octopusdeploy.APIKey) error {
+				return ioutil.WriteFile(secret, []byte(newAPIKey.APIKey), 0600)
+			},
+			Verify: true,
+		})
+		if err != nil {
+			_ = fmt.Errorf("error rotating API key: %v", err)
+			return
+		}
+
+		fmt.Printf("API key rotated: (%s) for %s, revoked %v\n", rotation.NewAPIKey.GetID(), item.Username, rotation.RevokedAPIKeyIDs)
+	}
+}
@@ -1,5 +1,10 @@
 package octopusdeploy
 
+const (
+	controlTypeDisplaySetting = "Octopus.ControlType"
+	controlTypeSensitive      = "Sensitive"
+)
+
 // ActionTemplateParameter represents an action template parameter.
 type ActionTemplateParameter struct {
 	DefaultValue    *PropertyValueResource `json:"DefaultValue,omitempty"`
@@ -16,3 +21,9 @@ func NewActionTemplateParameter() *ActionTemplateParameter {
 		resource: *newResource(),
 	}
 }
+
+// IsSensitive returns true if the parameter is displayed as a sensitive
+// control and its values must be supplied as sensitive values.
+func (a *ActionTemplateParameter) IsSensitive() bool {
+	return a.DisplaySettings[controlTypeDisplaySetting] == controlTypeSensitive
+}
@@ -66,7 +66,7 @@ func (s apiKeyService) GetByID(userID string, apiKeyID string) (*APIKey, error)
 	}
 
 	path := trimTemplate(s.getPath())
-	path = fmt.Sprintf(path+"%s/apikeys/%s", userID, apiKeyID)
+	path = fmt.Sprintf(path+"/%s/apikeys/%s", userID, apiKeyID)
 
 	resp, err := apiGet(s.getClient(), new(APIKey), path)
 	if err != nil {
@@ -101,3 +101,135 @@ func (s apiKeyService) Create(apiKey *APIKey) (*APIKey, error) {
 
 	return resp.(*APIKey), nil
 }
+
+// Revoke revokes the API key that belongs to the user by its ID. A revoked
+// API key can no longer be used to authenticate.
+func (s apiKeyService) Revoke(userID string, apiKeyID string) error {
+	if isEmpty(userID) {
+		return createInvalidParameterError(OperationRevoke, ParameterUserID)
+	}
+
+	if isEmpty(apiKeyID) {
+		return createInvalidParameterError(OperationRevoke, ParameterAPIKeyID)
+	}
+
+	err := validateInternalState(s)
+	if err != nil {
+		return err
+	}
+
+	path := trimTemplate(s.getPath())
+	path = fmt.Sprintf(path+"/%s/apikeys/%s", userID, apiKeyID)
+
+	return apiDelete(s.getClient(), path)
+}
+
+// APIKeySink receives a newly created API key during rotation, for example to
+// write it to a secret store. If it returns an error, the rotation is
+// abandoned and the new API key is revoked.
+type APIKeySink func(apiKey *APIKey) error
+
+// APIKeyRotationOptions configures Rotate.
+type APIKeyRotationOptions struct {
+	// Sink receives the new API key before the old key is revoked.
+	Sink APIKeySink
+
+	// Verify checks that the new API key can authenticate before it is
+	// handed to the sink.
+	Verify bool
+}
+
+// APIKeyRotation is the result of rotating an API key.
+type APIKeyRotation struct {
+	NewAPIKey        *APIKey
+	RevokedAPIKeyIDs []string
+	Verified         bool
+}
+
+// Rotate replaces the API key of the user that matches the input ID: it
+// creates a new API key with the same purpose, then revokes the replaced
+// key. Other API keys of the user are left in place, even if they share the
+// purpose. The new key can be verified and is handed to the sink before the
+// replaced key is revoked; if either step fails, the new key is revoked and
+// the replaced key is left in place.
+func (s apiKeyService) Rotate(userID string, apiKeyID string, options APIKeyRotationOptions) (*APIKeyRotation, error) {
+	if isEmpty(userID) {
+		return nil, createInvalidParameterError(OperationRotate, ParameterUserID)
+	}
+
+	if isEmpty(apiKeyID) {
+		return nil, createInvalidParameterError(OperationRotate, ParameterAPIKeyID)
+	}
+
+	existingAPIKeys, err := s.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var replacedAPIKey *APIKey
+	for _, existingAPIKey := range existingAPIKeys {
+		if existingAPIKey != nil && existingAPIKey.GetID() == apiKeyID {
+			replacedAPIKey = existingAPIKey
+			break
+		}
+	}
+
+	if replacedAPIKey == nil {
+		return nil, createResourceNotFoundError(s.getName(), "ID", apiKeyID)
+	}
+
+	newAPIKey, err := s.Create(NewAPIKey(replacedAPIKey.Purpose, userID))
+	if err != nil {
+		return nil, err
+	}
+
+	rotation := &APIKeyRotation{
+		NewAPIKey:        newAPIKey,
+		RevokedAPIKeyIDs: []string{},
+	}
+
+	if options.Verify {
+		if err := s.verify(userID, newAPIKey); err != nil {
+			return nil, s.abandonRotation(userID, newAPIKey, err)
+		}
+		rotation.Verified = true
+	}
+
+	if options.Sink != nil {
+		if err := options.Sink(newAPIKey); err != nil {
+			return nil, s.abandonRotation(userID, newAPIKey, err)
+		}
+	}
+
+	if err := s.Revoke(userID, apiKeyID); err != nil {
+		return rotation, err
+	}
+	rotation.RevokedAPIKeyIDs = append(rotation.RevokedAPIKeyIDs, apiKeyID)
+
+	return rotation, nil
+}
+
+// verify authenticates as the owner of the input API key and confirms that
+// it belongs to the expected user.
+func (s apiKeyService) verify(userID string, apiKey *APIKey) error {
+	client := s.getClient().New().Set(clientAPIKeyHTTPHeader, apiKey.APIKey)
+	users := newUserService(client, s.getPath(), emptyString, emptyString, emptyString, emptyString, emptyString, emptyString, emptyString, emptyString, emptyString)
+
+	user, err := users.GetMe()
+	if err != nil {
+		return fmt.Errorf("the new API key could not be verified: %v", err)
+	}
+
+	if user.GetID() != userID {
+		return fmt.Errorf("the new API key authenticated as user %s instead of %s", user.GetID(), userID)
+	}
+
+	return nil
+}
+
+func (s apiKeyService) abandonRotation(userID string, apiKey *APIKey, err error) error {
+	if revokeErr := s.Revoke(userID, apiKey.GetID()); revokeErr != nil {
+		return fmt.Errorf("%v; the new API key %s could not be revoked: %v", err, apiKey.GetID(), revokeErr)
+	}
+	return err
+}
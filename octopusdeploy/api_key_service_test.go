@@ -1,10 +1,17 @@
 package octopusdeploy
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/dghubble/sling"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewAPIKeyService(t *testing.T) {
@@ -86,3 +93,194 @@ func createAPIKeyService(t *testing.T) *apiKeyService {
 	testNewService(t, service, TestURIAPIKeys, ServiceAPIKeyService)
 	return service
 }
+
+type fakeAPIKeyServer struct {
+	apiKeys      []*APIKey
+	meUserID     string
+	revoked      []string
+	revokeStatus int
+}
+
+func (f *fakeAPIKeyServer) handle(t *testing.T) func(r *http.Request) (int, string) {
+	return func(r *http.Request) (int, string) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/users/me":
+			if r.Header.Get(clientAPIKeyHTTPHeader) != "API-NEWKEY" {
+				return http.StatusUnauthorized, `{"ErrorMessage":"unauthorized"}`
+			}
+			return http.StatusOK, `{"Id":"` + f.meUserID + `"}`
+		case r.Method == http.MethodGet && r.URL.Path == "/api/users/Users-1/apikeys":
+			data, err := json.Marshal(&APIKeys{Items: f.apiKeys})
+			require.NoError(t, err)
+			return http.StatusOK, string(data)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/users/Users-1/apikeys":
+			return http.StatusCreated, `{"Id":"APIKeys-3","ApiKey":"API-NEWKEY","Purpose":"ci","UserId":"Users-1"}`
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/users/Users-1/apikeys/"):
+			if f.revokeStatus != 0 {
+				return f.revokeStatus, `{"ErrorMessage":"cannot revoke"}`
+			}
+			f.revoked = append(f.revoked, strings.TrimPrefix(r.URL.Path, "/api/users/Users-1/apikeys/"))
+			return http.StatusOK, `{}`
+		}
+		return http.StatusNotFound, `{}`
+	}
+}
+
+func newFakeAPIKeyServer() *fakeAPIKeyServer {
+	return &fakeAPIKeyServer{
+		apiKeys: []*APIKey{
+			{Purpose: "ci", UserID: "Users-1", resource: resource{ID: "APIKeys-1"}},
+			{Purpose: "other", UserID: "Users-1", resource: resource{ID: "APIKeys-2"}},
+			{Purpose: "ci", UserID: "Users-1", resource: resource{ID: "APIKeys-4"}},
+		},
+		meUserID: "Users-1",
+	}
+}
+
+func TestAPIKeyServiceRevoke(t *testing.T) {
+	service := createAPIKeyService(t)
+	require.Equal(t, createInvalidParameterError(OperationRevoke, ParameterUserID), service.Revoke(emptyString, "APIKeys-1"))
+	require.Equal(t, createInvalidParameterError(OperationRevoke, ParameterAPIKeyID), service.Revoke("Users-1", emptyString))
+
+	server := newFakeAPIKeyServer()
+	service = newAPIKeyService(createFakeSling(server.handle(t)), TestURIUsers)
+	require.NoError(t, service.Revoke("Users-1", "APIKeys-1"))
+	require.Equal(t, []string{"APIKeys-1"}, server.revoked)
+}
+
+func TestAPIKeyServiceRotate(t *testing.T) {
+	service := createAPIKeyService(t)
+	_, err := service.Rotate(emptyString, "APIKeys-1", APIKeyRotationOptions{})
+	require.Equal(t, createInvalidParameterError(OperationRotate, ParameterUserID), err)
+	_, err = service.Rotate("Users-1", emptyString, APIKeyRotationOptions{})
+	require.Equal(t, createInvalidParameterError(OperationRotate, ParameterAPIKeyID), err)
+
+	server := newFakeAPIKeyServer()
+	service = newAPIKeyService(createFakeSling(server.handle(t)), TestURIUsers)
+
+	var stored *APIKey
+	rotation, err := service.Rotate("Users-1", "APIKeys-1", APIKeyRotationOptions{
+		Sink: func(apiKey *APIKey) error {
+			require.Empty(t, server.revoked)
+			stored = apiKey
+			return nil
+		},
+		Verify: true,
+	})
+	require.NoError(t, err)
+	require.True(t, rotation.Verified)
+	require.Equal(t, "API-NEWKEY", rotation.NewAPIKey.APIKey)
+	require.Equal(t, rotation.NewAPIKey, stored)
+	require.Equal(t, []string{"APIKeys-1"}, rotation.RevokedAPIKeyIDs)
+	require.Equal(t, []string{"APIKeys-1"}, server.revoked)
+
+	// another key with the same purpose is not revoked, and an unknown key
+	// is not rotated
+	server = newFakeAPIKeyServer()
+	service = newAPIKeyService(createFakeSling(server.handle(t)), TestURIUsers)
+
+	rotation, err = service.Rotate("Users-1", "APIKeys-4", APIKeyRotationOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"APIKeys-4"}, server.revoked)
+
+	_, err = service.Rotate("Users-1", "APIKeys-9", APIKeyRotationOptions{})
+	require.Equal(t, createResourceNotFoundError(service.getName(), "ID", "APIKeys-9"), err)
+	require.Equal(t, []string{"APIKeys-4"}, server.revoked)
+}
+
+func TestAPIKeyServiceRotateAbandoned(t *testing.T) {
+	// the sink fails, so the new key is revoked and the old key is kept
+	server := newFakeAPIKeyServer()
+	service := newAPIKeyService(createFakeSling(server.handle(t)), TestURIUsers)
+
+	rotation, err := service.Rotate("Users-1", "APIKeys-1", APIKeyRotationOptions{
+		Sink: func(apiKey *APIKey) error {
+			return errors.New("secret store is unavailable")
+		},
+	})
+	require.EqualError(t, err, "secret store is unavailable")
+	require.Nil(t, rotation)
+	require.Equal(t, []string{"APIKeys-3"}, server.revoked)
+
+	// the new key authenticates as another user
+	server = newFakeAPIKeyServer()
+	server.meUserID = "Users-2"
+	service = newAPIKeyService(createFakeSling(server.handle(t)), TestURIUsers)
+
+	rotation, err = service.Rotate("Users-1", "APIKeys-1", APIKeyRotationOptions{Verify: true})
+	require.Error(t, err)
+	require.Nil(t, rotation)
+	require.Equal(t, []string{"APIKeys-3"}, server.revoked)
+
+	// the new key cannot be revoked either
+	server = newFakeAPIKeyServer()
+	server.meUserID = "Users-2"
+	server.revokeStatus = http.StatusInternalServerError
+	service = newAPIKeyService(createFakeSling(server.handle(t)), TestURIUsers)
+
+	_, err = service.Rotate("Users-1", "APIKeys-1", APIKeyRotationOptions{Verify: true})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "APIKeys-3 could not be revoked")
+}
+
+func TestBuildAPIKeyAgeReport(t *testing.T) {
+	asOf := time.Date(2020, 9, 1, 0, 0, 0, 0, time.UTC)
+	created := func(days int) *time.Time {
+		t := asOf.AddDate(0, 0, -days)
+		return &t
+	}
+
+	users := []*User{
+		{Username: "alice", resource: resource{ID: "Users-1"}},
+		{IsService: true, Username: "deployer", resource: resource{ID: "Users-2"}},
+	}
+	apiKeys := map[string][]*APIKey{
+		"Users-1": {
+			{Created: created(10), Purpose: "laptop", resource: resource{ID: "APIKeys-1"}},
+			{Created: created(120), Purpose: "script", resource: resource{ID: "APIKeys-2"}},
+		},
+		"Users-2": {
+			{Created: created(365), Purpose: "ci", resource: resource{ID: "APIKeys-3"}},
+			{Purpose: "unknown", resource: resource{ID: "APIKeys-4"}},
+		},
+	}
+
+	report := BuildAPIKeyAgeReport(users, apiKeys, 90*24*time.Hour, asOf)
+	require.Len(t, report.Items, 2)
+	require.Equal(t, "APIKeys-3", report.Items[0].APIKeyID)
+	require.True(t, report.Items[0].IsService)
+	require.Equal(t, "deployer", report.Items[0].Username)
+	require.Equal(t, 365*24*time.Hour, report.Items[0].Age)
+	require.Equal(t, "APIKeys-2", report.Items[1].APIKeyID)
+	require.False(t, report.Items[1].IsService)
+
+	var buffer bytes.Buffer
+	require.NoError(t, report.WriteJSON(&buffer))
+	require.Contains(t, buffer.String(), `"ApiKeyId": "APIKeys-3"`)
+}
+
+func TestGetAPIKeysOlderThan(t *testing.T) {
+	c := &Client{}
+	_, err := c.GetAPIKeysOlderThan(0)
+	require.Equal(t, createInvalidParameterError(OperationGetAPIKeysOlderThan, ParameterMaxAge), err)
+
+	old := time.Now().AddDate(0, 0, -100)
+	client := createFakeSling(func(r *http.Request) (int, string) {
+		switch r.URL.Path {
+		case "/api/users/all":
+			return http.StatusOK, `[{"Id":"Users-1","Username":"deployer","IsService":true}]`
+		case "/api/users/Users-1/apikeys":
+			return http.StatusOK, `{"Items":[{"Id":"APIKeys-1","Purpose":"ci","Created":"` + old.Format(time.RFC3339) + `"}]}`
+		}
+		return http.StatusNotFound, `{}`
+	})
+	c = &Client{
+		APIKeys: newAPIKeyService(client, TestURIUsers),
+		Users:   newUserService(client, TestURIUsers, emptyString, emptyString, emptyString, emptyString, emptyString, emptyString, emptyString, emptyString, emptyString),
+	}
+
+	report, err := c.GetAPIKeysOlderThan(90 * 24 * time.Hour)
+	require.NoError(t, err)
+	require.Len(t, report.Items, 1)
+	require.True(t, report.Items[0].IsService)
+}
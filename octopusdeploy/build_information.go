@@ -27,3 +27,10 @@ func NewBuildInformation() *BuildInformation {
 		resource: *newResource(),
 	}
 }
+
+// BuildInformationCollection defines a collection of build information with
+// built-in support for paged results.
+type BuildInformationCollection struct {
+	Items []*BuildInformation `json:"Items"`
+	PagedResults
+}
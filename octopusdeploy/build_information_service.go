@@ -2,6 +2,7 @@ package octopusdeploy
 
 import (
 	"github.com/dghubble/sling"
+	"github.com/google/go-querystring/query"
 )
 
 type buildInformationService struct {
@@ -18,3 +19,38 @@ func newBuildInformationService(sling *sling.Sling, uriTemplate string, bulkPath
 
 	return buildInformationService
 }
+
+// Get returns a collection of build information based on the criteria
+// defined by its input query parameter. If an error occurs, an empty
+// collection is returned along with the associated error.
+func (s buildInformationService) Get(buildInformationQuery BuildInformationQuery) (*BuildInformationCollection, error) {
+	v, _ := query.Values(buildInformationQuery)
+	path := s.BasePath
+	encodedQueryString := v.Encode()
+	if len(encodedQueryString) > 0 {
+		path += "?" + encodedQueryString
+	}
+
+	resp, err := apiGet(s.getClient(), new(BuildInformationCollection), path)
+	if err != nil {
+		return &BuildInformationCollection{}, err
+	}
+
+	return resp.(*BuildInformationCollection), nil
+}
+
+// GetByID returns the build information that matches the input ID. If one
+// cannot be found, it returns nil and an error.
+func (s buildInformationService) GetByID(id string) (*BuildInformation, error) {
+	path, err := getByIDPath(s, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiGet(s.getClient(), new(BuildInformation), path)
+	if err != nil {
+		return nil, createResourceNotFoundError(s.getName(), "ID", id)
+	}
+
+	return resp.(*BuildInformation), nil
+}
@@ -0,0 +1,17 @@
+package octopusdeploy
+
+type Capability string
+
+const (
+	CapabilityBuildInformation            = Capability("BuildInformation")
+	CapabilityDashboardDynamic            = Capability("DashboardDynamic")
+	CapabilityDeploymentsCountedByWeek    = Capability("DeploymentsCountedByWeek")
+	CapabilityOctopusServerClusterSummary = Capability("OctopusServerClusterSummary")
+	CapabilityRunbooks                    = Capability("Runbooks")
+	CapabilityScopedUserRoles             = Capability("ScopedUserRoles")
+	CapabilitySpaces                      = Capability("Spaces")
+	CapabilityTenants                     = Capability("Tenants")
+	CapabilityTenantsMissingVariables     = Capability("TenantsMissingVariables")
+	CapabilityTenantVariables             = Capability("TenantVariables")
+	CapabilityWorkerPools                 = Capability("WorkerPools")
+)
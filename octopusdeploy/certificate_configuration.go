@@ -0,0 +1,33 @@
+package octopusdeploy
+
+// CertificateConfigurations defines a collection of certificate configurations
+// with built-in support for paged results.
+type CertificateConfigurations struct {
+	Items []*CertificateConfiguration `json:"Items"`
+	PagedResults
+}
+
+// CertificateConfiguration represents a certificate used by the Octopus server
+// itself, such as the one it presents to Tentacles.
+type CertificateConfiguration struct {
+	ArchivedOn               string   `json:"ArchivedOn,omitempty"`
+	HasPrivateKey            bool     `json:"HasPrivateKey"`
+	IsExpired                bool     `json:"IsExpired"`
+	IssuerCommonName         string   `json:"IssuerCommonName,omitempty"`
+	IssuerDistinguishedName  string   `json:"IssuerDistinguishedName,omitempty"`
+	IssuerOrganization       string   `json:"IssuerOrganization,omitempty"`
+	Name                     string   `json:"Name,omitempty"`
+	NotAfter                 string   `json:"NotAfter,omitempty"`
+	NotBefore                string   `json:"NotBefore,omitempty"`
+	SelfSigned               bool     `json:"SelfSigned"`
+	SerialNumber             string   `json:"SerialNumber,omitempty"`
+	SignatureAlgorithmName   string   `json:"SignatureAlgorithmName,omitempty"`
+	SubjectAlternativeNames  []string `json:"SubjectAlternativeNames,omitempty"`
+	SubjectCommonName        string   `json:"SubjectCommonName,omitempty"`
+	SubjectDistinguishedName string   `json:"SubjectDistinguishedName,omitempty"`
+	SubjectOrganization      string   `json:"SubjectOrganization,omitempty"`
+	Thumbprint               string   `json:"Thumbprint,omitempty"`
+	Version                  int      `json:"Version,omitempty"`
+
+	resource
+}
@@ -1,6 +1,9 @@
 package octopusdeploy
 
-import "github.com/dghubble/sling"
+import (
+	"github.com/dghubble/sling"
+	"github.com/google/go-querystring/query"
+)
 
 type certificateConfigurationService struct {
 	service
@@ -11,3 +14,39 @@ func newCertificateConfigurationService(sling *sling.Sling, uriTemplate string)
 		service: newService(ServiceCertificateConfigurationService, sling, uriTemplate),
 	}
 }
+
+// Get returns a collection of certificate configurations based on the
+// criteria defined by its input query parameter. If an error occurs, an empty
+// collection is returned along with the associated error.
+func (s certificateConfigurationService) Get(certificateConfigurationQuery CertificateConfigurationQuery) (*CertificateConfigurations, error) {
+	v, _ := query.Values(certificateConfigurationQuery)
+	path := s.BasePath
+	encodedQueryString := v.Encode()
+	if len(encodedQueryString) > 0 {
+		path += "?" + encodedQueryString
+	}
+
+	resp, err := apiGet(s.getClient(), new(CertificateConfigurations), path)
+	if err != nil {
+		return &CertificateConfigurations{}, err
+	}
+
+	return resp.(*CertificateConfigurations), nil
+}
+
+// GetByID returns the certificate configuration that matches the input ID,
+// such as "certificate-global". If one cannot be found, it returns nil and an
+// error.
+func (s certificateConfigurationService) GetByID(id string) (*CertificateConfiguration, error) {
+	path, err := getByIDPath(s, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiGet(s.getClient(), new(CertificateConfiguration), path)
+	if err != nil {
+		return nil, createResourceNotFoundError(s.getName(), "ID", id)
+	}
+
+	return resp.(*CertificateConfiguration), nil
+}
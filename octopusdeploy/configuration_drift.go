@@ -0,0 +1,87 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ConfigurationDrift describes a setting whose value on the Octopus server
+// differs from its desired value.
+type ConfigurationDrift struct {
+	Actual  interface{} `json:"Actual"`
+	Desired interface{} `json:"Desired"`
+	Name    string      `json:"Name"`
+}
+
+// DetectConfigurationDrift compares the settings of a desired configuration
+// section (such as an SMTPConfiguration) against the one returned by the
+// Octopus server and returns every setting that differs. If field names are
+// given, only those settings are compared, so that a desired section built
+// with only some settings checks just those settings; otherwise every setting
+// is compared, including those with a zero value such as a disabled flag.
+// Resource fields like the ID and links are ignored. Sensitive values are
+// compared by whether a value is set since the server never returns them.
+func DetectConfigurationDrift(desired interface{}, actual interface{}, fieldNames ...string) ([]ConfigurationDrift, error) {
+	desiredValue := reflect.Indirect(reflect.ValueOf(desired))
+	actualValue := reflect.Indirect(reflect.ValueOf(actual))
+
+	if !desiredValue.IsValid() || desiredValue.Kind() != reflect.Struct {
+		return nil, createInvalidParameterError(OperationDetectConfigurationDrift, ParameterDesired)
+	}
+
+	if !actualValue.IsValid() || actualValue.Type() != desiredValue.Type() {
+		return nil, createInvalidParameterError(OperationDetectConfigurationDrift, ParameterActual)
+	}
+
+	for _, fieldName := range fieldNames {
+		field, ok := desiredValue.Type().FieldByName(fieldName)
+		if !ok || field.Anonymous || len(field.PkgPath) > 0 || len(field.Index) > 1 {
+			return nil, createInvalidParameterError(OperationDetectConfigurationDrift, ParameterFieldNames)
+		}
+	}
+
+	drift := []ConfigurationDrift{}
+	for i := 0; i < desiredValue.NumField(); i++ {
+		field := desiredValue.Type().Field(i)
+		if field.Anonymous || len(field.PkgPath) > 0 {
+			continue
+		}
+
+		desiredField := desiredValue.Field(i)
+		actualField := actualValue.Field(i)
+
+		if len(fieldNames) > 0 && !ValidateStringInSlice(field.Name, fieldNames) {
+			continue
+		}
+
+		if field.Type == reflect.TypeOf(&SensitiveValue{}) {
+			desiredSensitiveValue, _ := desiredField.Interface().(*SensitiveValue)
+			actualSensitiveValue, _ := actualField.Interface().(*SensitiveValue)
+			desiredHasValue := desiredSensitiveValue != nil && desiredSensitiveValue.HasValue
+			hasValue := actualSensitiveValue != nil && actualSensitiveValue.HasValue
+			if desiredHasValue != hasValue {
+				drift = append(drift, ConfigurationDrift{
+					Actual:  hasValue,
+					Desired: desiredHasValue,
+					Name:    field.Name,
+				})
+			}
+			continue
+		}
+
+		if !reflect.DeepEqual(desiredField.Interface(), actualField.Interface()) {
+			drift = append(drift, ConfigurationDrift{
+				Actual:  actualField.Interface(),
+				Desired: desiredField.Interface(),
+				Name:    field.Name,
+			})
+		}
+	}
+
+	return drift, nil
+}
+
+// String returns a description of the drift suitable for logging.
+func (c ConfigurationDrift) String() string {
+	return fmt.Sprintf("%s: desired %v, actual %v", c.Name, c.Desired, c.Actual)
+}
@@ -0,0 +1,101 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectConfigurationDrift(t *testing.T) {
+	desired := NewSMTPConfiguration("smtp.example.com", 587, "octopus@example.com")
+	desired.EnableSSL = true
+	desired.SMTPLogin = "octopus"
+	desired.SMTPPassword = NewSensitiveValue("secret")
+
+	actual := NewSMTPConfiguration("smtp.example.com", 25, "octopus@example.com")
+	actual.ID = "smtp"
+	actual.SMTPLogin = "octopus"
+	actual.SMTPPassword = &SensitiveValue{HasValue: true}
+
+	drift, err := DetectConfigurationDrift(desired, actual)
+	require.NoError(t, err)
+	require.Equal(t, []ConfigurationDrift{
+		{Actual: false, Desired: true, Name: "EnableSSL"},
+		{Actual: 25, Desired: 587, Name: "SMTPPort"},
+	}, drift)
+
+	actual.EnableSSL = true
+	actual.SMTPPort = 587
+	actual.SMTPPassword = nil
+
+	drift, err = DetectConfigurationDrift(desired, actual)
+	require.NoError(t, err)
+	require.Equal(t, []ConfigurationDrift{
+		{Actual: false, Desired: true, Name: "SMTPPassword"},
+	}, drift)
+}
+
+func TestDetectConfigurationDriftToZeroValue(t *testing.T) {
+	desired := NewSMTPConfiguration("smtp.example.com", 587, "octopus@example.com")
+
+	actual := NewSMTPConfiguration("smtp.example.com", 587, "octopus@example.com")
+	actual.EnableSSL = true
+
+	drift, err := DetectConfigurationDrift(desired, actual)
+	require.NoError(t, err)
+	require.Equal(t, []ConfigurationDrift{
+		{Actual: true, Desired: false, Name: "EnableSSL"},
+	}, drift)
+
+	drift, err = DetectConfigurationDrift(NewMaintenanceConfiguration(false), NewMaintenanceConfiguration(true))
+	require.NoError(t, err)
+	require.Equal(t, []ConfigurationDrift{
+		{Actual: true, Desired: false, Name: "IsInMaintenanceMode"},
+	}, drift)
+}
+
+func TestDetectConfigurationDriftPartialDesiredSection(t *testing.T) {
+	desired := NewSMTPConfiguration("smtp.example.com", 0, emptyString)
+
+	actual := NewSMTPConfiguration("smtp.example.com", 587, "octopus@example.com")
+	actual.EnableSSL = true
+	actual.SMTPLogin = "octopus"
+	actual.Timeout = 12000
+
+	drift, err := DetectConfigurationDrift(desired, actual, "SMTPHost")
+	require.NoError(t, err)
+	require.Empty(t, drift)
+
+	actual.SMTPHost = "mail.example.com"
+
+	drift, err = DetectConfigurationDrift(desired, actual, "SMTPHost")
+	require.NoError(t, err)
+	require.Equal(t, []ConfigurationDrift{
+		{Actual: "mail.example.com", Desired: "smtp.example.com", Name: "SMTPHost"},
+	}, drift)
+
+	// a desired false is compared when named
+	drift, err = DetectConfigurationDrift(desired, actual, "EnableSSL")
+	require.NoError(t, err)
+	require.Equal(t, []ConfigurationDrift{
+		{Actual: true, Desired: false, Name: "EnableSSL"},
+	}, drift)
+}
+
+func TestDetectConfigurationDriftParameters(t *testing.T) {
+	drift, err := DetectConfigurationDrift(nil, NewMaintenanceConfiguration(true))
+	require.Equal(t, createInvalidParameterError(OperationDetectConfigurationDrift, ParameterDesired), err)
+	require.Nil(t, drift)
+
+	drift, err = DetectConfigurationDrift(NewMaintenanceConfiguration(true), NewUpgradeConfiguration(UpgradeNotificationModeAlwaysShow))
+	require.Equal(t, createInvalidParameterError(OperationDetectConfigurationDrift, ParameterActual), err)
+	require.Nil(t, drift)
+
+	drift, err = DetectConfigurationDrift(NewMaintenanceConfiguration(true), NewMaintenanceConfiguration(true), "Unknown")
+	require.Equal(t, createInvalidParameterError(OperationDetectConfigurationDrift, ParameterFieldNames), err)
+	require.Nil(t, drift)
+
+	drift, err = DetectConfigurationDrift(NewMaintenanceConfiguration(true), NewMaintenanceConfiguration(true))
+	require.NoError(t, err)
+	require.Empty(t, drift)
+}
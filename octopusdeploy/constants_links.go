@@ -30,6 +30,7 @@ const (
 	linkDashboardDynamic                  string = "DashboardDynamic"
 	linkDeploymentProcesses               string = "DeploymentProcesses"
 	linkDeployments                       string = "Deployments"
+	linkDeploymentTemplate                string = "DeploymentTemplate"
 	linkDiscoverMachine                   string = "DiscoverMachine"
 	linkDiscoverWorker                    string = "DiscoverWorker"
 	linkDynamicExtensionsFeaturesMetadata string = "DynamicExtensionsFeaturesMetadata"
@@ -48,6 +49,7 @@ const (
 	linkExternalUserSearch                string = "ExternalUserSearch"
 	linkFeaturesConfiguration             string = "FeaturesConfiguration"
 	linkFeeds                             string = "Feeds"
+	linkGroups                            string = "Groups"
 	linkInterruptions                     string = "Interruptions"
 	linkInvitations                       string = "Invitations"
 	linkIssueTrackers                     string = "IssueTrackers"
@@ -78,6 +80,7 @@ const (
 	linkPerformanceConfiguration          string = "PerformanceConfiguration"
 	linkPermissions                       string = "Permissions"
 	linkPermissionsConfiguration          string = "PermissionsConfiguration"
+	linkPreview                           string = "Preview"
 	linkProgression                       string = "Progression"
 	linkProjectGroups                     string = "ProjectGroups"
 	linkProjectPulse                      string = "ProjectPulse"
@@ -97,6 +100,7 @@ const (
 	linkScheduler                         string = "Scheduler"
 	linkScopedUserRoles                   string = "ScopedUserRoles"
 	linkSearchPackagesTemplate            string = "SearchPackagesTemplate"
+	linkSearchPackageVersionsTemplate     string = "SearchPackageVersionsTemplate"
 	linkSelf                              string = "Self"
 	linkServerConfiguration               string = "ServerConfiguration"
 	linkServerConfigurationSettings       string = "ServerConfigurationSettings"
@@ -106,6 +110,7 @@ const (
 	linkSignOut                           string = "SignOut"
 	linkSMTPConfiguration                 string = "SmtpConfiguration"
 	linkSMTPIsConfigured                  string = "SmtpIsConfigured"
+	linkSnapshotVariables                 string = "SnapshotVariables"
 	linkSpaceHome                         string = "SpaceHome"
 	linkSpaces                            string = "Spaces"
 	linkSubmit                            string = "Submit"
@@ -118,6 +123,7 @@ const (
 	linkTeamMembership                    string = "TeamMembership"
 	linkTeamMembershipPreviewTeam         string = "TeamMembershipPreviewTeam"
 	linkTeams                             string = "Teams"
+	linkTemplate                          string = "Template"
 	linkTenants                           string = "Tenants"
 	linkTenantsMissingVariables           string = "TenantsMissingVariables"
 	linkTenantsStatus                     string = "TenantsStatus"
@@ -1,32 +1,69 @@
 package octopusdeploy
 
 const (
-	OperationAdd                     string = "Add"
-	OperationAPIAdd                  string = "apiAdd"
-	OperationAPIDelete               string = "apiDelete"
-	OperationAPIGet                  string = "apiGet"
-	OperationAPIPost                 string = "apiPost"
-	OperationAPIUpdate               string = "apiUpdate"
-	OperationDelete                  string = "Delete"
-	OperationDeleteByID              string = "DeleteByID"
-	OperationGet                     string = "Get"
-	OperationGetAPIKeyByID           string = "GetAPIKeyByID"
-	OperationGetAPIKeys              string = "GetAPIKeys"
-	OperationGetAuthentication       string = "GetAuthentication"
-	OperationGetAuthenticationByUser string = "GetAuthenticationByUser"
-	OperationGetByID                 string = "GetByID"
-	OperationGetByIDs                string = "GetByIDs"
-	OperationGetByName               string = "GetByName"
-	OperationGetByPartialName        string = "GetByPartialName"
-	OperationGetByProjectID          string = "GetByProjectID"
-	OperationGetByUserID             string = "GetByUserID"
-	OperationGetChannels             string = "GetChannels"
-	OperationGetDeployments          string = "GetDeployments"
-	OperationGetProject              string = "GetProject"
-	OperationGetReleases             string = "GetReleases"
-	OperationGetSummary              string = "GetSummary"
-	OperationInstall                 string = "Install"
-	OperationReplace                 string = "Replace"
-	OperationSearchPackages          string = "SearchPackages"
-	OperationUpdate                  string = "Update"
+	OperationAdd                      string = "Add"
+	OperationAPIAdd                   string = "apiAdd"
+	OperationAPIDelete                string = "apiDelete"
+	OperationAPIGet                   string = "apiGet"
+	OperationAPIPost                  string = "apiPost"
+	OperationAPIUpdate                string = "apiUpdate"
+	OperationCancelQueuedTask         string = "CancelQueuedTask"
+	OperationCreateRelease            string = "CreateRelease"
+	OperationDelete                   string = "Delete"
+	OperationDeleteByID               string = "DeleteByID"
+	OperationDeployRelease            string = "DeployRelease"
+	OperationDetectConfigurationDrift string = "DetectConfigurationDrift"
+	OperationDrainNode                string = "DrainNode"
+	OperationGenerateReleaseNotes     string = "GenerateReleaseNotes"
+	OperationGet                      string = "Get"
+	OperationGetAPIKeyByID            string = "GetAPIKeyByID"
+	OperationGetAPIKeys               string = "GetAPIKeys"
+	OperationGetAPIKeysOlderThan      string = "GetAPIKeysOlderThan"
+	OperationGetAuthentication        string = "GetAuthentication"
+	OperationGetAuthenticationByUser  string = "GetAuthenticationByUser"
+	OperationGetByID                  string = "GetByID"
+	OperationGetByIDs                 string = "GetByIDs"
+	OperationGetByName                string = "GetByName"
+	OperationGetByPartialName         string = "GetByPartialName"
+	OperationGetByProjectAndVersion   string = "GetByProjectAndVersion"
+	OperationGetByProjectID           string = "GetByProjectID"
+	OperationGetByTenantID            string = "GetByTenantID"
+	OperationGetByUserID              string = "GetByUserID"
+	OperationGetChannels              string = "GetChannels"
+	OperationGetDeploymentPreview     string = "GetDeploymentPreview"
+	OperationGetDeployments           string = "GetDeployments"
+	OperationGetDeploymentsOfRelease  string = "GetDeploymentsOfRelease"
+	OperationGetDeploymentTemplate    string = "GetDeploymentTemplate"
+	OperationGetLatestForChannel      string = "GetLatestForChannel"
+	OperationGetPermissionEvaluator   string = "GetPermissionEvaluator"
+	OperationGetPermissionReport      string = "GetPermissionReport"
+	OperationGetProgression           string = "GetProgression"
+	OperationGetProject               string = "GetProject"
+	OperationGetReleases              string = "GetReleases"
+	OperationGetSummary               string = "GetSummary"
+	OperationGetTemplate              string = "GetTemplate"
+	OperationInstall                  string = "Install"
+	OperationParseServerVersion       string = "ParseServerVersion"
+	OperationPreviewTeam              string = "PreviewTeam"
+	OperationPromote                  string = "Promote"
+	OperationProvisionServiceAccount  string = "ProvisionServiceAccount"
+	OperationReplace                  string = "Replace"
+	OperationRescheduleQueuedTask     string = "RescheduleQueuedTask"
+	OperationRespondToInterruption    string = "RespondToInterruption"
+	OperationRespondToInterruptions   string = "RespondToInterruptions"
+	OperationRevoke                   string = "Revoke"
+	OperationRollback                 string = "Rollback"
+	OperationRotate                   string = "Rotate"
+	OperationScheduleDeployment       string = "ScheduleDeployment"
+	OperationSearchExternalUsers      string = "SearchExternalUsers"
+	OperationSearchGroups             string = "SearchGroups"
+	OperationSearchPackages           string = "SearchPackages"
+	OperationSearchPackageVersions    string = "SearchPackageVersions"
+	OperationSetValue                 string = "SetValue"
+	OperationSyncTeamMemberships      string = "SyncTeamMemberships"
+	OperationUpdate                   string = "Update"
+	OperationUpdateVariables          string = "UpdateVariables"
+	OperationValidateScopedUserRole   string = "ValidateScopedUserRole"
+	OperationWaitForTask              string = "WaitForTask"
+	OperationWatchInterruptions       string = "WatchInterruptions"
 )
@@ -1,46 +1,81 @@
 package octopusdeploy
 
 const (
-	ParameterAccessKey              string = "accessKey"
-	ParameterAccount                string = "account"
-	ParameterAccountResource        string = "accountResource"
-	ParameterAccountType            string = "accountType"
-	ParameterActionTemplate         string = "actionTemplate"
-	ParameterApplicationPassword    string = "applicationPassword"
-	ParameterArtifact               string = "artifact"
-	ParameterAPIKey                 string = "apiKey"
-	ParameterAPIKeyID               string = "apiKeyID"
-	ParameterCertificate            string = "certificate"
-	ParameterCertificateID          string = "certificateID"
-	ParameterChannel                string = "channel"
-	ParameterEnvironment            string = "environment"
-	ParameterFeed                   string = "feed"
-	ParameterID                     string = "id"
-	ParameterIDs                    string = "ids"
-	ParameterLibraryVariableSet     string = "libraryVariableSet"
-	ParameterMachinePolicy          string = "machinePolicy"
-	ParameterName                   string = "name"
-	ParameterOctopusURL             string = "octopusURL"
-	ParameterPackage                string = "package"
-	ParameterPartialName            string = "partialName"
-	ParameterPath                   string = "path"
-	ParameterPrivateKeyFile         string = "privateKeyFile"
-	ParameterProjectID              string = "projectID"
-	ParameterProject                string = "project"
-	ParameterRelease                string = "release"
-	ParameterReplacementCertificate string = "replacementCertificate"
-	ParameterResource               string = "resource"
-	ParameterRunbook                string = "runbook"
-	ParameterSecretKey              string = "secretKey"
-	ParameterSling                  string = "sling"
-	ParameterTagSet                 string = "tagSet"
-	ParameterTeam                   string = "team"
-	ParameterToken                  string = "token"
-	ParameterUser                   string = "user"
-	ParameterUserID                 string = "userID"
-	ParameterUsername               string = "username"
-	ParameterWorker                 string = "worker"
-	ParameterWorkerPool             string = "workerPool"
-	ParameterWorkerPoolResource     string = "workerPoolResource"
-	ParameterWorkerType             string = "workerType"
+	ParameterAccessKey                string = "accessKey"
+	ParameterAccount                  string = "account"
+	ParameterAccountResource          string = "accountResource"
+	ParameterAccountType              string = "accountType"
+	ParameterActionTemplate           string = "actionTemplate"
+	ParameterActual                   string = "actual"
+	ParameterApplicationPassword      string = "applicationPassword"
+	ParameterArtifact                 string = "artifact"
+	ParameterAPIKey                   string = "apiKey"
+	ParameterAPIKeyID                 string = "apiKeyID"
+	ParameterCertificate              string = "certificate"
+	ParameterCertificateID            string = "certificateID"
+	ParameterChannel                  string = "channel"
+	ParameterDeployment               string = "deployment"
+	ParameterDeploymentProcess        string = "deploymentProcess"
+	ParameterDesired                  string = "desired"
+	ParameterEnvironment              string = "environment"
+	ParameterEnvironmentID            string = "environmentID"
+	ParameterEnvironmentIDs           string = "environmentIDs"
+	ParameterSecurityGroupProvider    string = "securityGroupProvider"
+	ParameterFeed                     string = "feed"
+	ParameterFieldNames               string = "fieldNames"
+	ParameterFromEnvironmentID        string = "fromEnvironmentID"
+	ParameterID                       string = "id"
+	ParameterIDs                      string = "ids"
+	ParameterInterruption             string = "interruption"
+	ParameterSubmitRequest            string = "submitRequest"
+	ParameterLetsEncryptConfiguration string = "letsEncryptConfiguration"
+	ParameterLibraryVariableSet       string = "libraryVariableSet"
+	ParameterLibraryVariableSetID     string = "libraryVariableSetID"
+	ParameterMachinePolicy            string = "machinePolicy"
+	ParameterMaintenanceConfiguration string = "maintenanceConfiguration"
+	ParameterMaxAge                   string = "maxAge"
+	ParameterName                     string = "name"
+	ParameterOctopusServerNode        string = "octopusServerNode"
+	ParameterOctopusURL               string = "octopusURL"
+	ParameterPackage                  string = "package"
+	ParameterPackageID                string = "packageID"
+	ParameterPartialName              string = "partialName"
+	ParameterPath                     string = "path"
+	ParameterPerformanceConfiguration string = "performanceConfiguration"
+	ParameterPermission               string = "permission"
+	ParameterPermissions              string = "permissions"
+	ParameterPrivateKeyFile           string = "privateKeyFile"
+	ParameterProjectID                string = "projectID"
+	ParameterProject                  string = "project"
+	ParameterQueuedTask               string = "queuedTask"
+	ParameterQueueTime                string = "queueTime"
+	ParameterQueueTimeExpiry          string = "queueTimeExpiry"
+	ParameterRelease                  string = "release"
+	ParameterReleaseID                string = "releaseID"
+	ParameterReplacementCertificate   string = "replacementCertificate"
+	ParameterResource                 string = "resource"
+	ParameterRunbook                  string = "runbook"
+	ParameterScopedUserRole           string = "scopedUserRole"
+	ParameterSecretKey                string = "secretKey"
+	ParameterSling                    string = "sling"
+	ParameterSMTPConfiguration        string = "smtpConfiguration"
+	ParameterSpaceID                  string = "spaceID"
+	ParameterTagSet                   string = "tagSet"
+	ParameterTaskID                   string = "taskID"
+	ParameterTeam                     string = "team"
+	ParameterTemplateName             string = "templateName"
+	ParameterTenantID                 string = "tenantID"
+	ParameterTenantVariables          string = "tenantVariables"
+	ParameterToEnvironmentID          string = "toEnvironmentID"
+	ParameterToken                    string = "token"
+	ParameterUpgradeConfiguration     string = "upgradeConfiguration"
+	ParameterUser                     string = "user"
+	ParameterUserID                   string = "userID"
+	ParameterUsername                 string = "username"
+	ParameterUserRole                 string = "userRole"
+	ParameterVersion                  string = "version"
+	ParameterWorker                   string = "worker"
+	ParameterWorkerPool               string = "workerPool"
+	ParameterWorkerPoolResource       string = "workerPoolResource"
+	ParameterWorkerType               string = "workerType"
 )
@@ -0,0 +1,84 @@
+package octopusdeploy
+
+// Dashboard represents the deployment dashboard of an Octopus space, listing
+// the projects, environments and tenants along with the deployments that
+// relate them.
+type Dashboard struct {
+	Environments  []*DashboardEnvironment  `json:"Environments"`
+	IsFiltered    bool                     `json:"IsFiltered,omitempty"`
+	Items         []*DashboardItem         `json:"Items"`
+	PreviousItems []*DashboardItem         `json:"PreviousItems,omitempty"`
+	ProjectGroups []*DashboardProjectGroup `json:"ProjectGroups,omitempty"`
+	ProjectLimit  int                      `json:"ProjectLimit,omitempty"`
+	Projects      []*DashboardProject      `json:"Projects"`
+	Tenants       []*DashboardTenant       `json:"Tenants,omitempty"`
+}
+
+// DashboardEnvironment represents an environment as shown on the dashboard.
+type DashboardEnvironment struct {
+	ID    string            `json:"Id"`
+	Links map[string]string `json:"Links,omitempty"`
+	Name  string            `json:"Name"`
+}
+
+// DashboardProject represents a project as shown on the dashboard.
+type DashboardProject struct {
+	CanPerformUntenantedDeployment bool              `json:"CanPerformUntenantedDeployment,omitempty"`
+	EnvironmentIDs                 []string          `json:"EnvironmentIds,omitempty"`
+	ID                             string            `json:"Id"`
+	Links                          map[string]string `json:"Links,omitempty"`
+	Name                           string            `json:"Name"`
+	ProjectGroupID                 string            `json:"ProjectGroupId,omitempty"`
+	Slug                           string            `json:"Slug,omitempty"`
+	TenantedDeploymentMode         string            `json:"TenantedDeploymentMode,omitempty"`
+}
+
+// DashboardProjectGroup represents a project group as shown on the dashboard.
+type DashboardProjectGroup struct {
+	EnvironmentIDs []string          `json:"EnvironmentIds,omitempty"`
+	ID             string            `json:"Id"`
+	Links          map[string]string `json:"Links,omitempty"`
+	Name           string            `json:"Name"`
+}
+
+// DashboardTenant represents a tenant as shown on the dashboard.
+type DashboardTenant struct {
+	ID                  string              `json:"Id"`
+	Links               map[string]string   `json:"Links,omitempty"`
+	Name                string              `json:"Name"`
+	ProjectEnvironments map[string][]string `json:"ProjectEnvironments,omitempty"`
+	TenantTags          []string            `json:"TenantTags,omitempty"`
+}
+
+// GetEnvironmentByID returns the dashboard environment that matches the
+// input ID or nil if it cannot be found.
+func (d *Dashboard) GetEnvironmentByID(id string) *DashboardEnvironment {
+	for _, environment := range d.Environments {
+		if environment != nil && environment.ID == id {
+			return environment
+		}
+	}
+	return nil
+}
+
+// GetProjectByID returns the dashboard project that matches the input ID or
+// nil if it cannot be found.
+func (d *Dashboard) GetProjectByID(id string) *DashboardProject {
+	for _, project := range d.Projects {
+		if project != nil && project.ID == id {
+			return project
+		}
+	}
+	return nil
+}
+
+// GetTenantByID returns the dashboard tenant that matches the input ID or nil
+// if it cannot be found.
+func (d *Dashboard) GetTenantByID(id string) *DashboardTenant {
+	for _, tenant := range d.Tenants {
+		if tenant != nil && tenant.ID == id {
+			return tenant
+		}
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+// DashboardMatrixCell describes the release currently deployed to a project
+// and environment (and, for tenanted deployments, a tenant).
+type DashboardMatrixCell struct {
+	CompletedTime   *time.Time `json:"CompletedTime,omitempty"`
+	DeploymentID    string     `json:"DeploymentId,omitempty"`
+	EnvironmentID   string     `json:"EnvironmentId"`
+	EnvironmentName string     `json:"EnvironmentName,omitempty"`
+	IsCompleted     bool       `json:"IsCompleted"`
+	ProjectID       string     `json:"ProjectId"`
+	ProjectName     string     `json:"ProjectName,omitempty"`
+	ReleaseID       string     `json:"ReleaseId,omitempty"`
+	ReleaseVersion  string     `json:"ReleaseVersion,omitempty"`
+	State           TaskState  `json:"State,omitempty"`
+	TenantID        string     `json:"TenantId,omitempty"`
+	TenantName      string     `json:"TenantName,omitempty"`
+}
+
+// DashboardMatrix indexes the current deployments of a dashboard by project,
+// environment and tenant. Its JSON encoding lists the cells as GetCells
+// returns them.
+type DashboardMatrix struct {
+	Environments []*DashboardEnvironment `json:"Environments"`
+	Projects     []*DashboardProject     `json:"Projects"`
+	Tenants      []*DashboardTenant      `json:"Tenants"`
+
+	cells map[dashboardMatrixKey]*DashboardMatrixCell
+}
+
+type dashboardMatrixKey struct {
+	environmentID string
+	projectID     string
+	tenantID      string
+}
+
+// NewDashboardMatrix builds a matrix from the current items of a dashboard.
+// Untenanted deployments are indexed with an empty tenant ID.
+func NewDashboardMatrix(dashboard *Dashboard) *DashboardMatrix {
+	matrix := &DashboardMatrix{
+		cells: map[dashboardMatrixKey]*DashboardMatrixCell{},
+	}
+
+	if dashboard == nil {
+		return matrix
+	}
+
+	matrix.Environments = dashboard.Environments
+	matrix.Projects = dashboard.Projects
+	matrix.Tenants = dashboard.Tenants
+
+	for _, item := range dashboard.Items {
+		if item == nil || !item.IsCurrent {
+			continue
+		}
+
+		cell := &DashboardMatrixCell{
+			CompletedTime:  item.CompletedTime,
+			DeploymentID:   item.DeploymentID,
+			EnvironmentID:  item.EnvironmentID,
+			IsCompleted:    item.IsCompleted,
+			ProjectID:      item.ProjectID,
+			ReleaseID:      item.ReleaseID,
+			ReleaseVersion: item.ReleaseVersion,
+			State:          TaskState(item.State),
+			TenantID:       item.TenantID,
+		}
+
+		if environment := dashboard.GetEnvironmentByID(item.EnvironmentID); environment != nil {
+			cell.EnvironmentName = environment.Name
+		}
+
+		if project := dashboard.GetProjectByID(item.ProjectID); project != nil {
+			cell.ProjectName = project.Name
+		}
+
+		if tenant := dashboard.GetTenantByID(item.TenantID); tenant != nil {
+			cell.TenantName = tenant.Name
+		}
+
+		matrix.cells[dashboardMatrixKey{
+			environmentID: item.EnvironmentID,
+			projectID:     item.ProjectID,
+			tenantID:      item.TenantID,
+		}] = cell
+	}
+
+	return matrix
+}
+
+// MarshalJSON returns a dashboard matrix as its JSON encoding.
+func (m *DashboardMatrix) MarshalJSON() ([]byte, error) {
+	matrix := struct {
+		Cells        []*DashboardMatrixCell  `json:"Cells"`
+		Environments []*DashboardEnvironment `json:"Environments"`
+		Projects     []*DashboardProject     `json:"Projects"`
+		Tenants      []*DashboardTenant      `json:"Tenants"`
+	}{
+		Cells:        m.GetCells(),
+		Environments: m.Environments,
+		Projects:     m.Projects,
+		Tenants:      m.Tenants,
+	}
+
+	return json.Marshal(matrix)
+}
+
+// UnmarshalJSON sets this dashboard matrix to its representation in JSON.
+func (m *DashboardMatrix) UnmarshalJSON(b []byte) error {
+	var matrix struct {
+		Cells        []*DashboardMatrixCell  `json:"Cells"`
+		Environments []*DashboardEnvironment `json:"Environments"`
+		Projects     []*DashboardProject     `json:"Projects"`
+		Tenants      []*DashboardTenant      `json:"Tenants"`
+	}
+
+	if err := json.Unmarshal(b, &matrix); err != nil {
+		return err
+	}
+
+	m.Environments = matrix.Environments
+	m.Projects = matrix.Projects
+	m.Tenants = matrix.Tenants
+	m.cells = map[dashboardMatrixKey]*DashboardMatrixCell{}
+
+	for _, cell := range matrix.Cells {
+		if cell == nil {
+			continue
+		}
+
+		m.cells[dashboardMatrixKey{
+			environmentID: cell.EnvironmentID,
+			projectID:     cell.ProjectID,
+			tenantID:      cell.TenantID,
+		}] = cell
+	}
+
+	return nil
+}
+
+// Get returns the cell for the input project, environment and tenant IDs.
+// Use an empty tenant ID for untenanted deployments. If nothing has been
+// deployed, it returns nil.
+func (m *DashboardMatrix) Get(projectID string, environmentID string, tenantID string) *DashboardMatrixCell {
+	if m == nil {
+		return nil
+	}
+	return m.cells[dashboardMatrixKey{
+		environmentID: environmentID,
+		projectID:     projectID,
+		tenantID:      tenantID,
+	}]
+}
+
+// GetByName returns the cell for the input project, environment and tenant
+// names. Use an empty tenant name for untenanted deployments. If nothing has
+// been deployed, it returns nil.
+func (m *DashboardMatrix) GetByName(projectName string, environmentName string, tenantName string) *DashboardMatrixCell {
+	for _, cell := range m.GetCells() {
+		if cell.ProjectName == projectName && cell.EnvironmentName == environmentName && cell.TenantName == tenantName {
+			return cell
+		}
+	}
+	return nil
+}
+
+// GetCells returns all cells of the matrix, sorted by project, environment
+// and tenant name.
+func (m *DashboardMatrix) GetCells() []*DashboardMatrixCell {
+	cells := []*DashboardMatrixCell{}
+	if m == nil {
+		return cells
+	}
+
+	for _, cell := range m.cells {
+		cells = append(cells, cell)
+	}
+
+	sort.Slice(cells, func(i, j int) bool {
+		if cells[i].ProjectName != cells[j].ProjectName {
+			return cells[i].ProjectName < cells[j].ProjectName
+		}
+		if cells[i].EnvironmentName != cells[j].EnvironmentName {
+			return cells[i].EnvironmentName < cells[j].EnvironmentName
+		}
+		if cells[i].TenantName != cells[j].TenantName {
+			return cells[i].TenantName < cells[j].TenantName
+		}
+		return cells[i].TenantID < cells[j].TenantID
+	})
+
+	return cells
+}
+
+// GetByEnvironment returns the cells deployed to the input environment ID,
+// sorted by project and tenant name.
+func (m *DashboardMatrix) GetByEnvironment(environmentID string) []*DashboardMatrixCell {
+	cells := []*DashboardMatrixCell{}
+	for _, cell := range m.GetCells() {
+		if cell.EnvironmentID == environmentID {
+			cells = append(cells, cell)
+		}
+	}
+	return cells
+}
+
+// GetByProject returns the cells for the input project ID, sorted by
+// environment and tenant name.
+func (m *DashboardMatrix) GetByProject(projectID string) []*DashboardMatrixCell {
+	cells := []*DashboardMatrixCell{}
+	for _, cell := range m.GetCells() {
+		if cell.ProjectID == projectID {
+			cells = append(cells, cell)
+		}
+	}
+	return cells
+}
+
+// IsTenanted reports whether the matrix contains tenanted deployments.
+func (m *DashboardMatrix) IsTenanted() bool {
+	if m == nil {
+		return false
+	}
+	for key := range m.cells {
+		if !isEmpty(key.tenantID) {
+			return true
+		}
+	}
+	return false
+}
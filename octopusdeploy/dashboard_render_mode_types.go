@@ -0,0 +1,8 @@
+package octopusdeploy
+
+type DashboardRenderMode string
+
+const (
+	DashboardRenderModeConservativeRender = DashboardRenderMode("ConservativeRender")
+	DashboardRenderModeProgressiveRender  = DashboardRenderMode("ProgressiveRender")
+)
@@ -1,9 +1,13 @@
 package octopusdeploy
 
-import "github.com/dghubble/sling"
+import (
+	"github.com/dghubble/sling"
+	"github.com/google/go-querystring/query"
+)
 
 type dashboardService struct {
-	dashboardDynamicPath string
+	dashboardDynamicPath    string
+	dynamicUnsupportedError error
 
 	service
 }
@@ -14,3 +18,49 @@ func newDashboardService(sling *sling.Sling, uriTemplate string, dashboardDynami
 		service:              newService(ServiceDashboardService, sling, uriTemplate),
 	}
 }
+
+// Get returns the dashboard that matches the input query. If an error
+// occurs, it returns nil along with the associated error.
+func (s dashboardService) Get(dashboardQuery DashboardQuery) (*Dashboard, error) {
+	path, err := getPath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	v, _ := query.Values(dashboardQuery)
+	encodedQueryString := v.Encode()
+	if len(encodedQueryString) > 0 {
+		path += "?" + encodedQueryString
+	}
+
+	resp, err := apiGet(s.getClient(), new(Dashboard), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*Dashboard), nil
+}
+
+// GetDynamic returns the dynamic dashboard that matches the input query. The
+// dynamic dashboard is limited to the projects and environments specified in
+// the query. If an error occurs, it returns nil along with the associated
+// error.
+func (s dashboardService) GetDynamic(dashboardDynamicQuery DashboardDynamicQuery) (*Dashboard, error) {
+	if isEmpty(s.dashboardDynamicPath) {
+		return nil, s.getPathError(s.dynamicUnsupportedError)
+	}
+
+	v, _ := query.Values(dashboardDynamicQuery)
+	path := trimTemplate(s.dashboardDynamicPath)
+	encodedQueryString := v.Encode()
+	if len(encodedQueryString) > 0 {
+		path += "?" + encodedQueryString
+	}
+
+	resp, err := apiGet(s.getClient(), new(Dashboard), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*Dashboard), nil
+}
@@ -0,0 +1,143 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/dghubble/sling"
+	"github.com/stretchr/testify/require"
+)
+
+const testDashboardResponse = `{
+	"Environments": [
+		{"Id": "Environments-1", "Name": "Staging"},
+		{"Id": "Environments-2", "Name": "Production"}
+	],
+	"Items": [
+		{"ProjectId": "Projects-1", "EnvironmentId": "Environments-1", "ReleaseVersion": "1.1.0", "State": "Success", "IsCompleted": true, "IsCurrent": true, "CompletedTime": "2020-06-02T10:00:00Z"},
+		{"ProjectId": "Projects-1", "EnvironmentId": "Environments-2", "ReleaseVersion": "1.0.0", "State": "Success", "IsCompleted": true, "IsCurrent": true},
+		{"ProjectId": "Projects-1", "EnvironmentId": "Environments-2", "ReleaseVersion": "0.9.0", "State": "Success", "IsCompleted": true, "IsPrevious": true},
+		{"ProjectId": "Projects-2", "EnvironmentId": "Environments-2", "TenantId": "Tenants-1", "ReleaseVersion": "2.0.0", "State": "Executing", "IsCurrent": true}
+	],
+	"Projects": [
+		{"Id": "Projects-1", "Name": "Web"},
+		{"Id": "Projects-2", "Name": "Api"}
+	],
+	"Tenants": [
+		{"Id": "Tenants-1", "Name": "Acme"}
+	]
+}`
+
+func TestDashboardServiceNew(t *testing.T) {
+	ServiceFunction := newDashboardService
+	client := &sling.Sling{}
+	uriTemplate := emptyString
+	dashboardDynamicPath := emptyString
+	ServiceName := ServiceDashboardService
+
+	testCases := []struct {
+		name                 string
+		f                    func(*sling.Sling, string, string) *dashboardService
+		client               *sling.Sling
+		uriTemplate          string
+		dashboardDynamicPath string
+	}{
+		{"NilClient", ServiceFunction, nil, uriTemplate, dashboardDynamicPath},
+		{"EmptyURITemplate", ServiceFunction, client, emptyString, dashboardDynamicPath},
+		{"URITemplateWithWhitespace", ServiceFunction, client, whitespaceString, dashboardDynamicPath},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := tc.f(tc.client, tc.uriTemplate, tc.dashboardDynamicPath)
+			testNewService(t, service, uriTemplate, ServiceName)
+		})
+	}
+}
+
+func TestDashboardServiceGet(t *testing.T) {
+	client := createFakeSling(func(r *http.Request) (int, string) {
+		require.Equal(t, "/api/Spaces-1/dashboard", r.URL.Path)
+		require.Equal(t, "Projects-1", r.URL.Query().Get("projectId"))
+		return http.StatusOK, testDashboardResponse
+	})
+	service := newDashboardService(client, TestURIDashboard, TestURIDashboardDynamic)
+
+	dashboard, err := service.Get(DashboardQuery{ProjectID: "Projects-1"})
+	require.NoError(t, err)
+	require.NotNil(t, dashboard)
+	require.Len(t, dashboard.Environments, 2)
+	require.Len(t, dashboard.Items, 4)
+	require.Len(t, dashboard.Projects, 2)
+	require.Len(t, dashboard.Tenants, 1)
+}
+
+func TestDashboardServiceGetDynamic(t *testing.T) {
+	client := createFakeSling(func(r *http.Request) (int, string) {
+		require.Equal(t, "/api/Spaces-1/dashboard/dynamic", r.URL.Path)
+		require.Equal(t, []string{"Environments-2"}, r.URL.Query()["environments"])
+		return http.StatusOK, testDashboardResponse
+	})
+	service := newDashboardService(client, TestURIDashboard, TestURIDashboardDynamic)
+
+	dashboard, err := service.GetDynamic(DashboardDynamicQuery{Environments: []string{"Environments-2"}})
+	require.NoError(t, err)
+	require.NotNil(t, dashboard)
+
+	service = newDashboardService(client, TestURIDashboard, emptyString)
+	dashboard, err = service.GetDynamic(DashboardDynamicQuery{})
+	require.Equal(t, createInvalidPathError(ServiceDashboardService), err)
+	require.Nil(t, dashboard)
+}
+
+func TestDashboardMatrix(t *testing.T) {
+	client := createFakeSling(func(r *http.Request) (int, string) {
+		return http.StatusOK, testDashboardResponse
+	})
+	service := newDashboardService(client, TestURIDashboard, TestURIDashboardDynamic)
+
+	dashboard, err := service.Get(DashboardQuery{})
+	require.NoError(t, err)
+
+	matrix := NewDashboardMatrix(dashboard)
+	require.True(t, matrix.IsTenanted())
+	require.Len(t, matrix.GetCells(), 3)
+
+	cell := matrix.Get("Projects-1", "Environments-2", emptyString)
+	require.NotNil(t, cell)
+	require.Equal(t, "1.0.0", cell.ReleaseVersion)
+	require.Equal(t, TaskStateSuccess, cell.State)
+
+	cell = matrix.GetByName("Web", "Staging", emptyString)
+	require.NotNil(t, cell)
+	require.Equal(t, "1.1.0", cell.ReleaseVersion)
+	require.NotNil(t, cell.CompletedTime)
+
+	cell = matrix.Get("Projects-2", "Environments-2", "Tenants-1")
+	require.NotNil(t, cell)
+	require.Equal(t, "Acme", cell.TenantName)
+	require.Equal(t, TaskStateExecuting, cell.State)
+	require.Nil(t, matrix.Get("Projects-2", "Environments-2", emptyString))
+
+	cellJSON, err := json.Marshal(cell)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"EnvironmentId":"Environments-2","EnvironmentName":"Production","IsCompleted":false,"ProjectId":"Projects-2","ProjectName":"Api","ReleaseVersion":"2.0.0","State":"Executing","TenantId":"Tenants-1","TenantName":"Acme"}`, string(cellJSON))
+
+	production := matrix.GetByEnvironment("Environments-2")
+	require.Len(t, production, 2)
+	require.Equal(t, "Api", production[0].ProjectName)
+	require.Len(t, matrix.GetByProject("Projects-1"), 2)
+
+	matrixJSON, err := json.Marshal(matrix)
+	require.NoError(t, err)
+	require.Contains(t, string(matrixJSON), `"Cells":[`)
+
+	var decoded DashboardMatrix
+	require.NoError(t, json.Unmarshal(matrixJSON, &decoded))
+	require.Equal(t, matrix.GetCells(), decoded.GetCells())
+	require.Equal(t, matrix.Environments, decoded.Environments)
+	require.True(t, decoded.IsTenanted())
+	require.Equal(t, "Acme", decoded.Get("Projects-2", "Environments-2", "Tenants-1").TenantName)
+
+	require.Empty(t, NewDashboardMatrix(nil).GetCells())
+}
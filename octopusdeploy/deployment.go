@@ -61,3 +61,9 @@ func NewDeployment(name string, environmentID string, releaseID string) *Deploym
 func (d *Deployment) Validate() error {
 	return validator.New().Struct(d)
 }
+
+// ValidateFormValues checks the form values of the deployment against the
+// form of prompted variables of its deployment preview.
+func (d *Deployment) ValidateFormValues(form *Form) error {
+	return form.ValidateValues(d.FormValues)
+}
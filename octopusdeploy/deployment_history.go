@@ -0,0 +1,312 @@
+package octopusdeploy
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DeploymentHistory represents the deployment history report of an Octopus
+// space.
+type DeploymentHistory struct {
+	Items []*DeploymentHistoryItem `json:"Items"`
+}
+
+// DeploymentHistoryItem represents a single deployment in the deployment
+// history report.
+type DeploymentHistoryItem struct {
+	ChannelID       string     `json:"ChannelId,omitempty"`
+	ChannelName     string     `json:"ChannelName,omitempty"`
+	CompletedTime   *time.Time `json:"CompletedTime,omitempty"`
+	Created         *time.Time `json:"Created,omitempty"`
+	DeployedBy      string     `json:"DeployedBy,omitempty"`
+	DeploymentID    string     `json:"DeploymentId"`
+	DeploymentName  string     `json:"DeploymentName,omitempty"`
+	DurationSeconds int        `json:"DurationSeconds"`
+	EnvironmentID   string     `json:"EnvironmentId,omitempty"`
+	EnvironmentName string     `json:"EnvironmentName,omitempty"`
+	ProjectID       string     `json:"ProjectId,omitempty"`
+	ProjectName     string     `json:"ProjectName,omitempty"`
+	ProjectSlug     string     `json:"ProjectSlug,omitempty"`
+	QueueTime       *time.Time `json:"QueueTime,omitempty"`
+	ReleaseID       string     `json:"ReleaseId,omitempty"`
+	ReleaseVersion  string     `json:"ReleaseVersion,omitempty"`
+	StartTime       *time.Time `json:"StartTime,omitempty"`
+	TaskID          string     `json:"TaskId,omitempty"`
+	TaskState       TaskState  `json:"TaskState,omitempty"`
+	TenantID        string     `json:"TenantId,omitempty"`
+	TenantName      string     `json:"TenantName,omitempty"`
+}
+
+// deploymentHistoryXML mirrors the XML document returned by the server.
+// Timestamps are kept as strings since the server emits empty elements for
+// deployments that have not started or completed.
+type deploymentHistoryXML struct {
+	XMLName xml.Name `xml:"Deployments"`
+	Items   []struct {
+		ChannelID       string `xml:"ChannelId"`
+		ChannelName     string `xml:"ChannelName"`
+		CompletedTime   string `xml:"CompletedTime"`
+		Created         string `xml:"Created"`
+		DeployedBy      string `xml:"DeployedBy"`
+		DeploymentID    string `xml:"DeploymentId"`
+		DeploymentName  string `xml:"DeploymentName"`
+		DurationSeconds string `xml:"DurationSeconds"`
+		EnvironmentID   string `xml:"EnvironmentId"`
+		EnvironmentName string `xml:"EnvironmentName"`
+		ProjectID       string `xml:"ProjectId"`
+		ProjectName     string `xml:"ProjectName"`
+		ProjectSlug     string `xml:"ProjectSlug"`
+		QueueTime       string `xml:"QueueTime"`
+		ReleaseID       string `xml:"ReleaseId"`
+		ReleaseVersion  string `xml:"ReleaseVersion"`
+		StartTime       string `xml:"StartTime"`
+		TaskID          string `xml:"TaskId"`
+		TaskState       string `xml:"TaskState"`
+		TenantID        string `xml:"TenantId"`
+		TenantName      string `xml:"TenantName"`
+	} `xml:"Deployment"`
+}
+
+// deploymentHistoryColumns are the CSV columns written by WriteCSV.
+var deploymentHistoryColumns = []string{
+	"DeploymentId",
+	"DeploymentName",
+	"ProjectId",
+	"ProjectName",
+	"ProjectSlug",
+	"TenantId",
+	"TenantName",
+	"ReleaseId",
+	"ReleaseVersion",
+	"EnvironmentId",
+	"EnvironmentName",
+	"ChannelId",
+	"ChannelName",
+	"Created",
+	"QueueTime",
+	"StartTime",
+	"CompletedTime",
+	"DurationSeconds",
+	"DeployedBy",
+	"TaskId",
+	"TaskState",
+}
+
+// ParseDeploymentHistory decodes the XML deployment history report.
+func ParseDeploymentHistory(r io.Reader) (*DeploymentHistory, error) {
+	document := new(deploymentHistoryXML)
+	if err := xml.NewDecoder(r).Decode(document); err != nil {
+		return nil, err
+	}
+
+	history := &DeploymentHistory{
+		Items: []*DeploymentHistoryItem{},
+	}
+
+	for _, element := range document.Items {
+		item := &DeploymentHistoryItem{
+			ChannelID:       element.ChannelID,
+			ChannelName:     element.ChannelName,
+			DeployedBy:      element.DeployedBy,
+			DeploymentID:    element.DeploymentID,
+			DeploymentName:  element.DeploymentName,
+			EnvironmentID:   element.EnvironmentID,
+			EnvironmentName: element.EnvironmentName,
+			ProjectID:       element.ProjectID,
+			ProjectName:     element.ProjectName,
+			ProjectSlug:     element.ProjectSlug,
+			ReleaseID:       element.ReleaseID,
+			ReleaseVersion:  element.ReleaseVersion,
+			TaskID:          element.TaskID,
+			TaskState:       TaskState(element.TaskState),
+			TenantID:        element.TenantID,
+			TenantName:      element.TenantName,
+		}
+
+		var err error
+		if item.CompletedTime, err = parseDeploymentHistoryTime(element.CompletedTime); err != nil {
+			return nil, err
+		}
+		if item.Created, err = parseDeploymentHistoryTime(element.Created); err != nil {
+			return nil, err
+		}
+		if item.QueueTime, err = parseDeploymentHistoryTime(element.QueueTime); err != nil {
+			return nil, err
+		}
+		if item.StartTime, err = parseDeploymentHistoryTime(element.StartTime); err != nil {
+			return nil, err
+		}
+
+		if durationSeconds := strings.TrimSpace(element.DurationSeconds); !isEmpty(durationSeconds) {
+			duration, err := strconv.ParseFloat(durationSeconds, 64)
+			if err != nil {
+				return nil, err
+			}
+			item.DurationSeconds = int(duration)
+		}
+
+		history.Items = append(history.Items, item)
+	}
+
+	return history, nil
+}
+
+func parseDeploymentHistoryTime(value string) (*time.Time, error) {
+	value = strings.TrimSpace(value)
+	if isEmpty(value) {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func formatDeploymentHistoryTime(t *time.Time) string {
+	if t == nil {
+		return empty
+	}
+	return t.Format(time.RFC3339)
+}
+
+// Filter returns the deployments that match the input query. Deployments
+// that have not completed are excluded when a completion time range is
+// specified.
+func (h *DeploymentHistory) Filter(deploymentHistoryQuery DeploymentHistoryQuery) *DeploymentHistory {
+	filtered := &DeploymentHistory{
+		Items: []*DeploymentHistoryItem{},
+	}
+
+	for _, item := range h.Items {
+		if item == nil {
+			continue
+		}
+
+		if len(deploymentHistoryQuery.EnvironmentIDs) > 0 && !ValidateStringInSlice(item.EnvironmentID, deploymentHistoryQuery.EnvironmentIDs) {
+			continue
+		}
+
+		if len(deploymentHistoryQuery.ProjectIDs) > 0 && !ValidateStringInSlice(item.ProjectID, deploymentHistoryQuery.ProjectIDs) {
+			continue
+		}
+
+		if len(deploymentHistoryQuery.TenantIDs) > 0 && !ValidateStringInSlice(item.TenantID, deploymentHistoryQuery.TenantIDs) {
+			continue
+		}
+
+		if deploymentHistoryQuery.FromCompletedTime != nil && (item.CompletedTime == nil || item.CompletedTime.Before(*deploymentHistoryQuery.FromCompletedTime)) {
+			continue
+		}
+
+		if deploymentHistoryQuery.ToCompletedTime != nil && (item.CompletedTime == nil || item.CompletedTime.After(*deploymentHistoryQuery.ToCompletedTime)) {
+			continue
+		}
+
+		filtered.Items = append(filtered.Items, item)
+	}
+
+	return filtered
+}
+
+// CountByWeek returns the number of completed deployments per week. Weeks
+// start on Monday (UTC) and weeks without deployments are omitted.
+func (h *DeploymentHistory) CountByWeek() *DeploymentsCountedByWeek {
+	counts := map[time.Time]int{}
+	for _, item := range h.Items {
+		if item == nil || item.CompletedTime == nil {
+			continue
+		}
+		counts[getStartOfWeek(*item.CompletedTime)]++
+	}
+
+	weekStarts := []time.Time{}
+	for weekStart := range counts {
+		weekStarts = append(weekStarts, weekStart)
+	}
+	sort.Slice(weekStarts, func(i, j int) bool {
+		return weekStarts[i].Before(weekStarts[j])
+	})
+
+	deploymentsCountedByWeek := &DeploymentsCountedByWeek{
+		Items: []*DeploymentCountByWeek{},
+	}
+	for _, weekStart := range weekStarts {
+		weekStart := weekStart
+		weekEnd := weekStart.AddDate(0, 0, 7).Add(-time.Nanosecond)
+		deploymentsCountedByWeek.Items = append(deploymentsCountedByWeek.Items, &DeploymentCountByWeek{
+			Count:     counts[weekStart],
+			WeekEnd:   &weekEnd,
+			WeekStart: &weekStart,
+		})
+		deploymentsCountedByWeek.TotalCount += counts[weekStart]
+	}
+
+	return deploymentsCountedByWeek
+}
+
+func getStartOfWeek(t time.Time) time.Time {
+	t = t.UTC()
+	daysSinceMonday := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+}
+
+// WriteCSV writes the deployment history to the input writer as CSV with a
+// header row.
+func (h *DeploymentHistory) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(deploymentHistoryColumns); err != nil {
+		return err
+	}
+
+	for _, item := range h.Items {
+		if item == nil {
+			continue
+		}
+
+		record := []string{
+			item.DeploymentID,
+			item.DeploymentName,
+			item.ProjectID,
+			item.ProjectName,
+			item.ProjectSlug,
+			item.TenantID,
+			item.TenantName,
+			item.ReleaseID,
+			item.ReleaseVersion,
+			item.EnvironmentID,
+			item.EnvironmentName,
+			item.ChannelID,
+			item.ChannelName,
+			formatDeploymentHistoryTime(item.Created),
+			formatDeploymentHistoryTime(item.QueueTime),
+			formatDeploymentHistoryTime(item.StartTime),
+			formatDeploymentHistoryTime(item.CompletedTime),
+			strconv.Itoa(item.DurationSeconds),
+			item.DeployedBy,
+			item.TaskID,
+			string(item.TaskState),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteJSON writes the deployment history to the input writer as indented
+// JSON.
+func (h *DeploymentHistory) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent(empty, tab)
+	return encoder.Encode(h)
+}
@@ -0,0 +1,461 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+// deploymentMetricsPageSize is the number of deployments and tasks requested
+// per call when gathering the input for GetDeploymentMetrics.
+var deploymentMetricsPageSize = 100
+
+// DeploymentMetricsQuery defines the deployments from which
+// GetDeploymentMetrics computes its measures. Deployments are selected by
+// the time they were created; a zero From or To leaves that end of the
+// window open.
+type DeploymentMetricsQuery struct {
+	EnvironmentIDs           []string
+	From                     time.Time
+	ProductionEnvironmentIDs []string
+	ProjectIDs               []string
+	TenantIDs                []string
+	To                       time.Time
+}
+
+// DeploymentMetricsInput holds the deployments, releases, build information
+// and server tasks from which deployment metrics are computed. Deployments
+// without a matching completed task are ignored. If ProductionEnvironmentIDs
+// is empty, every environment is treated as production.
+type DeploymentMetricsInput struct {
+	BuildInformation         []*BuildInformation
+	Deployments              []*Deployment
+	From                     time.Time
+	ProductionEnvironmentIDs []string
+	Releases                 []*Release
+	Tasks                    []*Task
+	To                       time.Time
+}
+
+// DeploymentMetrics contains DORA-style measures computed over a window of
+// deployments. Durations are serialized to JSON in nanoseconds.
+type DeploymentMetrics struct {
+	ChangeFailureRate   *ChangeFailureRate     `json:"ChangeFailureRate"`
+	DeploymentFrequency []*DeploymentFrequency `json:"DeploymentFrequency"`
+	From                time.Time              `json:"From"`
+	LeadTimeForChanges  *LeadTimeForChanges    `json:"LeadTimeForChanges"`
+	MeanTimeToRestore   *MeanTimeToRestore     `json:"MeanTimeToRestore"`
+	To                  time.Time              `json:"To"`
+}
+
+// DeploymentFrequency describes how often an environment was deployed to.
+type DeploymentFrequency struct {
+	DeploymentsPerDay  float64 `json:"DeploymentsPerDay"`
+	DeploymentsPerWeek float64 `json:"DeploymentsPerWeek"`
+	EnvironmentID      string  `json:"EnvironmentId"`
+	Failed             int     `json:"Failed"`
+	IsProduction       bool    `json:"IsProduction"`
+	Successful         int     `json:"Successful"`
+}
+
+// ChangeFailureRate describes the proportion of production deployments that
+// failed.
+type ChangeFailureRate struct {
+	Deployments int     `json:"Deployments"`
+	Failed      int     `json:"Failed"`
+	Rate        float64 `json:"Rate"`
+}
+
+// LeadTimeForChanges describes the time from a build being created to its
+// release first being deployed successfully to production.
+type LeadTimeForChanges struct {
+	Mean     time.Duration `json:"Mean"`
+	Median   time.Duration `json:"Median"`
+	Releases int           `json:"Releases"`
+}
+
+// MeanTimeToRestore describes the time from a failed production deployment
+// to the next successful deployment of the same project, environment and
+// tenant.
+type MeanTimeToRestore struct {
+	Failures int           `json:"Failures"`
+	Mean     time.Duration `json:"Mean"`
+	Restored int           `json:"Restored"`
+}
+
+type deploymentOutcome struct {
+	completedTime time.Time
+	deployment    *Deployment
+	failed        bool
+}
+
+func (o *deploymentOutcome) environmentID() string {
+	if o.deployment.EnvironmentID == nil {
+		return emptyString
+	}
+	return *o.deployment.EnvironmentID
+}
+
+func (o *deploymentOutcome) releaseID() string {
+	if o.deployment.ReleaseID == nil {
+		return emptyString
+	}
+	return *o.deployment.ReleaseID
+}
+
+func (o *deploymentOutcome) streamKey() string {
+	return fmt.Sprintf("%s|%s|%s", o.deployment.ProjectID, o.environmentID(), o.deployment.TenantID)
+}
+
+// isFailedTaskState returns true for task states that count as a failed
+// change. Canceled tasks are not counted as successes or failures.
+func isFailedTaskState(state TaskState) bool {
+	return state == TaskStateFailed || state == TaskStateTimedOut
+}
+
+func isInWindow(t *time.Time, from time.Time, to time.Time) bool {
+	if t == nil {
+		return from.IsZero() && to.IsZero()
+	}
+	if !from.IsZero() && t.Before(from) {
+		return false
+	}
+	if !to.IsZero() && t.After(to) {
+		return false
+	}
+	return true
+}
+
+func getMedianDuration(durations []time.Duration) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+
+	sorted := append([]time.Duration{}, durations...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+	return sorted[middle]
+}
+
+func getMeanDuration(durations []time.Duration) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+
+	var total time.Duration
+	for _, duration := range durations {
+		total += duration
+	}
+	return total / time.Duration(len(durations))
+}
+
+// CalculateDeploymentMetrics computes deployment frequency per environment,
+// lead time for changes, change failure rate and mean time to restore from
+// the input. It does not call the Octopus API.
+func CalculateDeploymentMetrics(input DeploymentMetricsInput) (*DeploymentMetrics, error) {
+	if !input.From.IsZero() && !input.To.IsZero() && input.To.Before(input.From) {
+		return nil, fmt.Errorf("the end of the deployment metrics window (%s) is before its start (%s)", input.To.Format(time.RFC3339), input.From.Format(time.RFC3339))
+	}
+
+	tasks := map[string]*Task{}
+	for _, task := range input.Tasks {
+		if task != nil {
+			tasks[task.GetID()] = task
+		}
+	}
+
+	isProduction := func(environmentID string) bool {
+		return len(input.ProductionEnvironmentIDs) == 0 || ValidateStringInSlice(environmentID, input.ProductionEnvironmentIDs)
+	}
+
+	// collect completed deployments created within the window
+	outcomes := []*deploymentOutcome{}
+	for _, deployment := range input.Deployments {
+		if deployment == nil || !isInWindow(deployment.Created, input.From, input.To) {
+			continue
+		}
+
+		task, ok := tasks[deployment.TaskID]
+		if !ok || task.CompletedTime == nil {
+			continue
+		}
+
+		if task.State != TaskStateSuccess && !isFailedTaskState(task.State) {
+			continue
+		}
+
+		outcomes = append(outcomes, &deploymentOutcome{
+			completedTime: *task.CompletedTime,
+			deployment:    deployment,
+			failed:        isFailedTaskState(task.State),
+		})
+	}
+
+	sort.SliceStable(outcomes, func(i, j int) bool {
+		return outcomes[i].completedTime.Before(outcomes[j].completedTime)
+	})
+
+	from, to := input.From, input.To
+	if len(outcomes) > 0 {
+		if from.IsZero() {
+			for _, outcome := range outcomes {
+				if outcome.deployment.Created != nil && (from.IsZero() || outcome.deployment.Created.Before(from)) {
+					from = *outcome.deployment.Created
+				}
+			}
+			if from.IsZero() {
+				from = outcomes[0].completedTime
+			}
+		}
+		if to.IsZero() {
+			to = outcomes[len(outcomes)-1].completedTime
+		}
+	}
+
+	metrics := &DeploymentMetrics{
+		ChangeFailureRate:   &ChangeFailureRate{},
+		DeploymentFrequency: []*DeploymentFrequency{},
+		From:                from,
+		LeadTimeForChanges:  &LeadTimeForChanges{},
+		MeanTimeToRestore:   &MeanTimeToRestore{},
+		To:                  to,
+	}
+
+	// deployment frequency and change failure rate
+	frequencies := map[string]*DeploymentFrequency{}
+	for _, outcome := range outcomes {
+		environmentID := outcome.environmentID()
+		frequency, ok := frequencies[environmentID]
+		if !ok {
+			frequency = &DeploymentFrequency{
+				EnvironmentID: environmentID,
+				IsProduction:  isProduction(environmentID),
+			}
+			frequencies[environmentID] = frequency
+			metrics.DeploymentFrequency = append(metrics.DeploymentFrequency, frequency)
+		}
+
+		if outcome.failed {
+			frequency.Failed++
+		} else {
+			frequency.Successful++
+		}
+
+		if frequency.IsProduction {
+			metrics.ChangeFailureRate.Deployments++
+			if outcome.failed {
+				metrics.ChangeFailureRate.Failed++
+			}
+		}
+	}
+
+	days := to.Sub(from).Hours() / 24
+	if days < 1 {
+		days = 1
+	}
+	for _, frequency := range metrics.DeploymentFrequency {
+		frequency.DeploymentsPerDay = float64(frequency.Successful) / days
+		frequency.DeploymentsPerWeek = frequency.DeploymentsPerDay * 7
+	}
+	sort.Slice(metrics.DeploymentFrequency, func(i, j int) bool {
+		return metrics.DeploymentFrequency[i].EnvironmentID < metrics.DeploymentFrequency[j].EnvironmentID
+	})
+
+	if metrics.ChangeFailureRate.Deployments > 0 {
+		metrics.ChangeFailureRate.Rate = float64(metrics.ChangeFailureRate.Failed) / float64(metrics.ChangeFailureRate.Deployments)
+	}
+
+	// lead time for changes
+	releases := map[string]*Release{}
+	for _, release := range input.Releases {
+		if release != nil {
+			releases[release.GetID()] = release
+		}
+	}
+
+	buildCreated := map[string]time.Time{}
+	for _, buildInformation := range input.BuildInformation {
+		if buildInformation != nil {
+			buildCreated[buildInformation.PackageID+"|"+buildInformation.Version] = buildInformation.Created
+		}
+	}
+
+	leadTimes := []time.Duration{}
+	deployedReleases := map[string]bool{}
+	for _, outcome := range outcomes {
+		releaseID := outcome.releaseID()
+		if outcome.failed || !isProduction(outcome.environmentID()) || deployedReleases[releaseID] {
+			continue
+		}
+		deployedReleases[releaseID] = true
+
+		release, ok := releases[releaseID]
+		if !ok {
+			continue
+		}
+
+		var earliest time.Time
+		for _, packageBuildInformation := range release.BuildInformation {
+			if packageBuildInformation == nil {
+				continue
+			}
+			created, ok := buildCreated[packageBuildInformation.PackageID+"|"+packageBuildInformation.Version]
+			if ok && !created.IsZero() && (earliest.IsZero() || created.Before(earliest)) {
+				earliest = created
+			}
+		}
+
+		if earliest.IsZero() || outcome.completedTime.Before(earliest) {
+			continue
+		}
+
+		leadTimes = append(leadTimes, outcome.completedTime.Sub(earliest))
+	}
+
+	metrics.LeadTimeForChanges.Mean = getMeanDuration(leadTimes)
+	metrics.LeadTimeForChanges.Median = getMedianDuration(leadTimes)
+	metrics.LeadTimeForChanges.Releases = len(leadTimes)
+
+	// mean time to restore
+	failedSince := map[string]time.Time{}
+	restoreTimes := []time.Duration{}
+	for _, outcome := range outcomes {
+		if !isProduction(outcome.environmentID()) {
+			continue
+		}
+
+		key := outcome.streamKey()
+		since, isFailing := failedSince[key]
+		if outcome.failed {
+			if !isFailing {
+				failedSince[key] = outcome.completedTime
+				metrics.MeanTimeToRestore.Failures++
+			}
+			continue
+		}
+
+		if isFailing {
+			restoreTimes = append(restoreTimes, outcome.completedTime.Sub(since))
+			delete(failedSince, key)
+		}
+	}
+
+	metrics.MeanTimeToRestore.Mean = getMeanDuration(restoreTimes)
+	metrics.MeanTimeToRestore.Restored = len(restoreTimes)
+
+	return metrics, nil
+}
+
+// WriteJSON writes the metrics to the input writer as indented JSON.
+func (m *DeploymentMetrics) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent(empty, tab)
+	return encoder.Encode(m)
+}
+
+// GetDeploymentMetrics gathers the deployments, server tasks, releases and
+// build information that match the input query and computes deployment
+// metrics from them.
+func (c *Client) GetDeploymentMetrics(deploymentMetricsQuery DeploymentMetricsQuery) (*DeploymentMetrics, error) {
+	input := DeploymentMetricsInput{
+		BuildInformation:         []*BuildInformation{},
+		Deployments:              []*Deployment{},
+		From:                     deploymentMetricsQuery.From,
+		ProductionEnvironmentIDs: deploymentMetricsQuery.ProductionEnvironmentIDs,
+		Releases:                 []*Release{},
+		Tasks:                    []*Task{},
+		To:                       deploymentMetricsQuery.To,
+	}
+
+	// deployments are returned newest first; stop paging once a page reaches
+	// past the start of the window
+	deploymentsQuery := DeploymentsQuery{
+		Environments: deploymentMetricsQuery.EnvironmentIDs,
+		Projects:     deploymentMetricsQuery.ProjectIDs,
+		Take:         deploymentMetricsPageSize,
+		Tenants:      deploymentMetricsQuery.TenantIDs,
+	}
+	for {
+		deployments, err := c.Deployments.Get(deploymentsQuery)
+		if err != nil {
+			return nil, err
+		}
+
+		isPastWindow := false
+		for _, deployment := range deployments.Items {
+			if !deploymentMetricsQuery.From.IsZero() && deployment.Created != nil && deployment.Created.Before(deploymentMetricsQuery.From) {
+				isPastWindow = true
+				continue
+			}
+			input.Deployments = append(input.Deployments, deployment)
+		}
+
+		deploymentsQuery.Skip += len(deployments.Items)
+		if isPastWindow || len(deployments.Items) == 0 || deploymentsQuery.Skip >= deployments.TotalResults {
+			break
+		}
+	}
+
+	taskIDs := []string{}
+	releaseIDs := []string{}
+	for _, deployment := range input.Deployments {
+		if !isEmpty(deployment.TaskID) {
+			taskIDs = append(taskIDs, deployment.TaskID)
+		}
+
+		if deployment.ReleaseID != nil && !ValidateStringInSlice(*deployment.ReleaseID, releaseIDs) {
+			releaseIDs = append(releaseIDs, *deployment.ReleaseID)
+		}
+	}
+
+	for start := 0; start < len(taskIDs); start += deploymentMetricsPageSize {
+		end := start + deploymentMetricsPageSize
+		if end > len(taskIDs) {
+			end = len(taskIDs)
+		}
+
+		tasks, err := c.Tasks.GetAll(TasksQuery{IDs: taskIDs[start:end]})
+		if err != nil {
+			return nil, err
+		}
+		input.Tasks = append(input.Tasks, tasks...)
+	}
+
+	for _, releaseID := range releaseIDs {
+		release, err := c.Releases.GetByID(releaseID)
+		if err != nil {
+			return nil, err
+		}
+		input.Releases = append(input.Releases, release)
+
+		for _, packageBuildInformation := range release.BuildInformation {
+			if packageBuildInformation == nil {
+				continue
+			}
+
+			buildInformationCollection, err := c.BuildInformation.Get(BuildInformationQuery{
+				Filter:    packageBuildInformation.Version,
+				PackageID: packageBuildInformation.PackageID,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			for _, buildInformation := range buildInformationCollection.Items {
+				if buildInformation.PackageID == packageBuildInformation.PackageID && buildInformation.Version == packageBuildInformation.Version {
+					input.BuildInformation = append(input.BuildInformation, buildInformation)
+				}
+			}
+		}
+	}
+
+	return CalculateDeploymentMetrics(input)
+}
@@ -0,0 +1,213 @@
+package octopusdeploy
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testDeploymentMetricsDeployments = `[
+	{"Id": "Deployments-1", "ReleaseId": "Releases-1", "EnvironmentId": "Environments-1", "ProjectId": "Projects-1", "TaskId": "ServerTasks-1", "Created": "2020-06-01T09:00:00Z"},
+	{"Id": "Deployments-2", "ReleaseId": "Releases-1", "EnvironmentId": "Environments-2", "ProjectId": "Projects-1", "TaskId": "ServerTasks-2", "Created": "2020-06-02T07:00:00Z"},
+	{"Id": "Deployments-3", "ReleaseId": "Releases-2", "EnvironmentId": "Environments-1", "ProjectId": "Projects-1", "TaskId": "ServerTasks-3", "Created": "2020-06-05T09:00:00Z"},
+	{"Id": "Deployments-4", "ReleaseId": "Releases-2", "EnvironmentId": "Environments-2", "ProjectId": "Projects-1", "TaskId": "ServerTasks-4", "Created": "2020-06-06T09:00:00Z"},
+	{"Id": "Deployments-5", "ReleaseId": "Releases-2", "EnvironmentId": "Environments-2", "ProjectId": "Projects-1", "TaskId": "ServerTasks-5", "Created": "2020-06-06T11:00:00Z"},
+	{"Id": "Deployments-6", "ReleaseId": "Releases-2", "EnvironmentId": "Environments-2", "ProjectId": "Projects-1", "TaskId": "ServerTasks-6", "Created": "2020-06-07T09:00:00Z"},
+	{"Id": "Deployments-7", "ReleaseId": "Releases-2", "EnvironmentId": "Environments-2", "ProjectId": "Projects-1", "TaskId": "ServerTasks-7", "Created": "2020-05-20T09:00:00Z"},
+	{"Id": "Deployments-8", "ReleaseId": "Releases-2", "EnvironmentId": "Environments-2", "ProjectId": "Projects-1", "TaskId": "ServerTasks-8", "Created": "2020-06-10T09:00:00Z"}
+]`
+
+const testDeploymentMetricsTasks = `[
+	{"Id": "ServerTasks-1", "State": "Success", "CompletedTime": "2020-06-01T09:10:00Z"},
+	{"Id": "ServerTasks-2", "State": "Success", "CompletedTime": "2020-06-02T08:00:00Z"},
+	{"Id": "ServerTasks-3", "State": "Success", "CompletedTime": "2020-06-05T09:30:00Z"},
+	{"Id": "ServerTasks-4", "State": "Failed", "CompletedTime": "2020-06-06T10:00:00Z"},
+	{"Id": "ServerTasks-5", "State": "Success", "CompletedTime": "2020-06-06T12:00:00Z"},
+	{"Id": "ServerTasks-6", "State": "Canceled", "CompletedTime": "2020-06-07T09:05:00Z"},
+	{"Id": "ServerTasks-7", "State": "Success", "CompletedTime": "2020-05-20T09:10:00Z"},
+	{"Id": "ServerTasks-8", "State": "TimedOut", "CompletedTime": "2020-06-10T10:00:00Z"}
+]`
+
+const testDeploymentMetricsReleases = `[
+	{"Id": "Releases-1", "Version": "1.0.0", "BuildInformation": [{"PackageId": "web", "Version": "1.0.0"}]},
+	{"Id": "Releases-2", "Version": "1.1.0", "BuildInformation": [{"PackageId": "web", "Version": "1.1.0"}, {"PackageId": "api", "Version": "2.0.0"}]}
+]`
+
+const testDeploymentMetricsBuildInformation = `[
+	{"Id": "BuildInformation-1", "PackageId": "web", "Version": "1.0.0", "Created": "2020-06-01T08:00:00Z"},
+	{"Id": "BuildInformation-2", "PackageId": "web", "Version": "1.1.0", "Created": "2020-06-05T10:00:00Z"},
+	{"Id": "BuildInformation-3", "PackageId": "api", "Version": "2.0.0", "Created": "2020-06-05T08:00:00Z"}
+]`
+
+func createDeploymentMetricsInput(t *testing.T) DeploymentMetricsInput {
+	input := DeploymentMetricsInput{
+		From:                     time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC),
+		ProductionEnvironmentIDs: []string{"Environments-2"},
+		To:                       time.Date(2020, 6, 15, 0, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, json.Unmarshal([]byte(testDeploymentMetricsDeployments), &input.Deployments))
+	require.NoError(t, json.Unmarshal([]byte(testDeploymentMetricsTasks), &input.Tasks))
+	require.NoError(t, json.Unmarshal([]byte(testDeploymentMetricsReleases), &input.Releases))
+	require.NoError(t, json.Unmarshal([]byte(testDeploymentMetricsBuildInformation), &input.BuildInformation))
+	return input
+}
+
+func requireExpectedDeploymentMetrics(t *testing.T, metrics *DeploymentMetrics) {
+	require.NotNil(t, metrics)
+
+	require.Len(t, metrics.DeploymentFrequency, 2)
+	staging := metrics.DeploymentFrequency[0]
+	require.Equal(t, "Environments-1", staging.EnvironmentID)
+	require.False(t, staging.IsProduction)
+	require.Equal(t, 2, staging.Successful)
+	require.Equal(t, 0, staging.Failed)
+	require.InDelta(t, 2.0/14.0, staging.DeploymentsPerDay, 0.0001)
+	require.InDelta(t, 1.0, staging.DeploymentsPerWeek, 0.0001)
+
+	production := metrics.DeploymentFrequency[1]
+	require.Equal(t, "Environments-2", production.EnvironmentID)
+	require.True(t, production.IsProduction)
+	require.Equal(t, 2, production.Successful)
+	require.Equal(t, 2, production.Failed)
+
+	require.Equal(t, 4, metrics.ChangeFailureRate.Deployments)
+	require.Equal(t, 2, metrics.ChangeFailureRate.Failed)
+	require.Equal(t, 0.5, metrics.ChangeFailureRate.Rate)
+
+	// Releases-1: 24h from build to production; Releases-2: 28h from the
+	// earliest of its builds to its first successful production deployment
+	require.Equal(t, 2, metrics.LeadTimeForChanges.Releases)
+	require.Equal(t, 26*time.Hour, metrics.LeadTimeForChanges.Mean)
+	require.Equal(t, 26*time.Hour, metrics.LeadTimeForChanges.Median)
+
+	require.Equal(t, 2, metrics.MeanTimeToRestore.Failures)
+	require.Equal(t, 1, metrics.MeanTimeToRestore.Restored)
+	require.Equal(t, 2*time.Hour, metrics.MeanTimeToRestore.Mean)
+}
+
+func TestCalculateDeploymentMetrics(t *testing.T) {
+	input := createDeploymentMetricsInput(t)
+
+	metrics, err := CalculateDeploymentMetrics(input)
+	require.NoError(t, err)
+	requireExpectedDeploymentMetrics(t, metrics)
+
+	var buffer bytes.Buffer
+	require.NoError(t, metrics.WriteJSON(&buffer))
+	decoded := new(DeploymentMetrics)
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), decoded))
+	require.Equal(t, metrics.ChangeFailureRate, decoded.ChangeFailureRate)
+	require.Equal(t, metrics.LeadTimeForChanges, decoded.LeadTimeForChanges)
+}
+
+func TestCalculateDeploymentMetricsAllEnvironmentsAreProduction(t *testing.T) {
+	input := createDeploymentMetricsInput(t)
+	input.ProductionEnvironmentIDs = nil
+
+	metrics, err := CalculateDeploymentMetrics(input)
+	require.NoError(t, err)
+	require.Equal(t, 6, metrics.ChangeFailureRate.Deployments)
+	require.Equal(t, 2, metrics.ChangeFailureRate.Failed)
+
+	// the first successful deployment of each release now counts
+	require.Equal(t, 2, metrics.LeadTimeForChanges.Releases)
+	require.Equal(t, 80*time.Minute, metrics.LeadTimeForChanges.Mean)
+}
+
+func TestCalculateDeploymentMetricsEmpty(t *testing.T) {
+	metrics, err := CalculateDeploymentMetrics(DeploymentMetricsInput{})
+	require.NoError(t, err)
+	require.Empty(t, metrics.DeploymentFrequency)
+	require.Equal(t, 0.0, metrics.ChangeFailureRate.Rate)
+	require.Equal(t, time.Duration(0), metrics.LeadTimeForChanges.Mean)
+
+	_, err = CalculateDeploymentMetrics(DeploymentMetricsInput{
+		From: time.Date(2020, 6, 15, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC),
+	})
+	require.Error(t, err)
+}
+
+func TestGetDeploymentMetrics(t *testing.T) {
+	deploymentMetricsPageSize = 3
+	defer func() { deploymentMetricsPageSize = 100 }()
+
+	deployments := []*Deployment{}
+	tasks := []*Task{}
+	releases := []*Release{}
+	buildInformation := []*BuildInformation{}
+	require.NoError(t, json.Unmarshal([]byte(testDeploymentMetricsDeployments), &deployments))
+	require.NoError(t, json.Unmarshal([]byte(testDeploymentMetricsTasks), &tasks))
+	require.NoError(t, json.Unmarshal([]byte(testDeploymentMetricsReleases), &releases))
+	require.NoError(t, json.Unmarshal([]byte(testDeploymentMetricsBuildInformation), &buildInformation))
+
+	// the server returns deployments newest first
+	ordered := []*Deployment{deployments[7], deployments[5], deployments[4], deployments[3], deployments[2], deployments[1], deployments[0], deployments[6]}
+	requestedPages := 0
+
+	client := createFakeSling(func(r *http.Request) (int, string) {
+		var body interface{}
+		switch {
+		case r.URL.Path == "/api/Spaces-1/deployments":
+			requestedPages++
+			require.Equal(t, []string{"Projects-1"}, r.URL.Query()["projects"])
+			skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+			end := skip + deploymentMetricsPageSize
+			if end > len(ordered) {
+				end = len(ordered)
+			}
+			body = &Deployments{Items: ordered[skip:end], PagedResults: PagedResults{TotalResults: len(ordered)}}
+		case r.URL.Path == "/api/tasks":
+			items := []*Task{}
+			for _, task := range tasks {
+				if ValidateStringInSlice(task.GetID(), r.URL.Query()["ids"]) {
+					items = append(items, task)
+				}
+			}
+			body = &Tasks{Items: items}
+		case strings.HasPrefix(r.URL.Path, "/api/Spaces-1/releases/"):
+			for _, release := range releases {
+				if strings.HasSuffix(r.URL.Path, "/"+release.GetID()) {
+					body = release
+				}
+			}
+		case r.URL.Path == "/api/Spaces-1/build-information":
+			items := []*BuildInformation{}
+			for _, item := range buildInformation {
+				if item.PackageID == r.URL.Query().Get("packageId") && item.Version == r.URL.Query().Get("filter") {
+					items = append(items, item)
+				}
+			}
+			body = &BuildInformationCollection{Items: items}
+		default:
+			return http.StatusNotFound, `{}`
+		}
+
+		data, err := json.Marshal(body)
+		require.NoError(t, err)
+		return http.StatusOK, string(data)
+	})
+
+	c := &Client{
+		BuildInformation: newBuildInformationService(client, TestURIBuildInformation, TestURIBuildInformationBulk),
+		Deployments:      newDeploymentService(client, TestURIDeployments),
+		Releases:         newReleaseService(client, TestURIReleases),
+		Tasks:            newTaskService(client, TestURITasks, TestURITaskTypes),
+	}
+
+	metrics, err := c.GetDeploymentMetrics(DeploymentMetricsQuery{
+		From:                     time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC),
+		ProductionEnvironmentIDs: []string{"Environments-2"},
+		ProjectIDs:               []string{"Projects-1"},
+		To:                       time.Date(2020, 6, 15, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, requestedPages)
+	requireExpectedDeploymentMetrics(t, metrics)
+}
@@ -0,0 +1,27 @@
+package octopusdeploy
+
+// DeploymentPreview describes a deployment of a release to an environment,
+// or a tenant in an environment, before it is created: the steps of the
+// deployment process and the machines they target, and the form of the
+// prompted variables whose values are submitted in Deployment.FormValues.
+type DeploymentPreview struct {
+	Changes                       []*ReleaseChanges         `json:"Changes"`
+	ChangesMarkdown               string                    `json:"ChangesMarkdown,omitempty"`
+	Form                          *Form                     `json:"Form,omitempty"`
+	StepsToExclude                []*DeploymentTemplateStep `json:"StepsToExclude"`
+	UseGuidedFailureModeByDefault bool                      `json:"UseGuidedFailureModeByDefault"`
+}
+
+// DeploymentTemplateStep is a step of a deployment preview. Steps that can
+// be skipped can be excluded from a deployment through
+// Deployment.SkipActions.
+type DeploymentTemplateStep struct {
+	ActionID                string   `json:"ActionId,omitempty"`
+	ActionName              string   `json:"ActionName,omitempty"`
+	ActionNumber            string   `json:"ActionNumber,omitempty"`
+	CanBeSkipped            bool     `json:"CanBeSkipped"`
+	HasNoApplicableMachines bool     `json:"HasNoApplicableMachines"`
+	IsDisabled              bool     `json:"IsDisabled"`
+	MachineNames            []string `json:"MachineNames"`
+	Roles                   []string `json:"Roles"`
+}
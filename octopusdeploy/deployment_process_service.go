@@ -2,6 +2,7 @@ package octopusdeploy
 
 import (
 	"github.com/dghubble/sling"
+	"github.com/fqjony/go-octopusdeploy/uritemplates"
 )
 
 type deploymentProcessService struct {
@@ -41,6 +42,45 @@ func (s deploymentProcessService) GetByID(id string) (*DeploymentProcess, error)
 	return resp.(*DeploymentProcess), nil
 }
 
+// GetTemplate returns the release template of the deployment process for the
+// input channel. The channel ID may be empty to use the default channel.
+func (s deploymentProcessService) GetTemplate(deploymentProcess *DeploymentProcess, channelID string) (*ReleaseTemplate, error) {
+	if deploymentProcess == nil {
+		return nil, createInvalidParameterError(OperationGetTemplate, ParameterDeploymentProcess)
+	}
+
+	template := deploymentProcess.GetLinks()[linkTemplate]
+	if isEmpty(template) {
+		path, err := getByIDPath(s, deploymentProcess.GetID())
+		if err != nil {
+			return nil, err
+		}
+		template = path + "/template{?channel,releaseId}"
+	}
+
+	uriTemplate, err := uritemplates.Parse(template)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]interface{})
+	if !isEmpty(channelID) {
+		values["channel"] = channelID
+	}
+
+	path, err := uriTemplate.Expand(values)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiGet(s.getClient(), new(ReleaseTemplate), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*ReleaseTemplate), nil
+}
+
 func (s deploymentProcessService) Update(resource DeploymentProcess) (*DeploymentProcess, error) {
 	path, err := getUpdatePath(s, &resource)
 	if err != nil {
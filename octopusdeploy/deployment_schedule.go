@@ -0,0 +1,386 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// QueuedTask is a deployment or a runbook run whose task is queued, either
+// to start as soon as possible or at its queue time. Exactly one of
+// Deployment and RunbookRun is set.
+type QueuedTask struct {
+	Deployment *Deployment `json:"Deployment,omitempty"`
+	RunbookRun *RunbookRun `json:"RunbookRun,omitempty"`
+}
+
+// GetEnvironmentID returns the ID of the environment of the deployment or
+// runbook run.
+func (q *QueuedTask) GetEnvironmentID() string {
+	if q.Deployment != nil {
+		if q.Deployment.EnvironmentID == nil {
+			return emptyString
+		}
+		return *q.Deployment.EnvironmentID
+	}
+
+	if q.RunbookRun != nil {
+		return q.RunbookRun.EnvironmentID
+	}
+
+	return emptyString
+}
+
+// GetID returns the ID of the deployment or runbook run.
+func (q *QueuedTask) GetID() string {
+	if q.Deployment != nil {
+		return q.Deployment.GetID()
+	}
+
+	if q.RunbookRun != nil {
+		return q.RunbookRun.GetID()
+	}
+
+	return emptyString
+}
+
+// GetQueueTime returns the time the task is scheduled to start, or nil if it
+// starts as soon as possible.
+func (q *QueuedTask) GetQueueTime() *time.Time {
+	if q.Deployment != nil {
+		return q.Deployment.QueueTime
+	}
+
+	if q.RunbookRun != nil {
+		return q.RunbookRun.QueueTime
+	}
+
+	return nil
+}
+
+// GetQueueTimeExpiry returns the time after which the task no longer starts,
+// or nil if it has none.
+func (q *QueuedTask) GetQueueTimeExpiry() *time.Time {
+	if q.Deployment != nil {
+		return q.Deployment.QueueTimeExpiry
+	}
+
+	if q.RunbookRun != nil {
+		return q.RunbookRun.QueueTimeExpiry
+	}
+
+	return nil
+}
+
+// GetTaskID returns the ID of the task of the deployment or runbook run.
+func (q *QueuedTask) GetTaskID() string {
+	if q.Deployment != nil {
+		return q.Deployment.TaskID
+	}
+
+	if q.RunbookRun != nil {
+		return q.RunbookRun.TaskID
+	}
+
+	return emptyString
+}
+
+// getWindow returns the window in which the task may start. A task without a
+// queue time expiry may only start at its queue time.
+func (q *QueuedTask) getWindow() (time.Time, time.Time, bool) {
+	queueTime := q.GetQueueTime()
+	if queueTime == nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	queueTimeExpiry := q.GetQueueTimeExpiry()
+	if queueTimeExpiry == nil {
+		return *queueTime, *queueTime, true
+	}
+
+	return *queueTime, *queueTimeExpiry, true
+}
+
+// isQueuedBetween returns true if the task is scheduled to start between the
+// input times, either of which may be zero for an open range. Tasks that
+// start as soon as possible are only included in an open range.
+func (q *QueuedTask) isQueuedBetween(from time.Time, to time.Time) bool {
+	if from.IsZero() && to.IsZero() {
+		return true
+	}
+
+	queueTime := q.GetQueueTime()
+	if queueTime == nil {
+		return false
+	}
+
+	if !from.IsZero() && queueTime.Before(from) {
+		return false
+	}
+
+	return to.IsZero() || !queueTime.After(to)
+}
+
+// ScheduleConflict is a pair of deployments or runbook runs to the same
+// environment that are scheduled to start in overlapping windows.
+type ScheduleConflict struct {
+	EnvironmentID string      `json:"EnvironmentId"`
+	First         *QueuedTask `json:"First"`
+	Second        *QueuedTask `json:"Second"`
+}
+
+// Error describes the conflict.
+func (c *ScheduleConflict) Error() string {
+	return fmt.Sprintf("the windows of %s and %s in the environment (%s) overlap", c.First.GetID(), c.Second.GetID(), c.EnvironmentID)
+}
+
+// FindScheduleConflicts returns the pairs of tasks to the same environment
+// whose windows overlap. The window of a task runs from its queue time to its
+// queue time expiry, or is the queue time alone if it has no expiry. Tasks
+// that start as soon as possible are not scheduled and never conflict.
+func FindScheduleConflicts(queuedTasks []*QueuedTask) []*ScheduleConflict {
+	conflicts := []*ScheduleConflict{}
+
+	for i, first := range queuedTasks {
+		if first == nil {
+			continue
+		}
+
+		firstStart, firstEnd, ok := first.getWindow()
+		if !ok {
+			continue
+		}
+
+		for _, second := range queuedTasks[i+1:] {
+			if second == nil || second.GetEnvironmentID() != first.GetEnvironmentID() {
+				continue
+			}
+
+			secondStart, secondEnd, ok := second.getWindow()
+			if !ok {
+				continue
+			}
+
+			if !firstStart.After(secondEnd) && !secondStart.After(firstEnd) {
+				conflicts = append(conflicts, &ScheduleConflict{
+					EnvironmentID: first.GetEnvironmentID(),
+					First:         first,
+					Second:        second,
+				})
+			}
+		}
+	}
+
+	return conflicts
+}
+
+// validateScheduleWindow checks that a task is scheduled and that its queue
+// time expiry, if any, is after its queue time.
+func validateScheduleWindow(methodName string, queueTime time.Time, queueTimeExpiry time.Time) error {
+	if queueTime.IsZero() {
+		return createInvalidParameterError(methodName, ParameterQueueTime)
+	}
+
+	if !queueTimeExpiry.IsZero() && !queueTimeExpiry.After(queueTime) {
+		return createInvalidParameterError(methodName, ParameterQueueTimeExpiry)
+	}
+
+	return nil
+}
+
+// ScheduleDeployment creates the deployment so that it starts at the queue
+// time and, if the queue time expiry is not zero, no later than the expiry,
+// such as within an approved maintenance window.
+func (c *Client) ScheduleDeployment(deployment *Deployment, queueTime time.Time, queueTimeExpiry time.Time) (*Deployment, error) {
+	if deployment == nil {
+		return nil, createInvalidParameterError(OperationScheduleDeployment, ParameterDeployment)
+	}
+
+	if err := validateScheduleWindow(OperationScheduleDeployment, queueTime, queueTimeExpiry); err != nil {
+		return nil, err
+	}
+
+	deployment.QueueTime = &queueTime
+	deployment.QueueTimeExpiry = nil
+	if !queueTimeExpiry.IsZero() {
+		deployment.QueueTimeExpiry = &queueTimeExpiry
+	}
+
+	return c.Deployments.Add(deployment)
+}
+
+// GetQueuedTasks returns the deployments and runbook runs whose tasks are
+// queued in the environments, or in every environment if none are given,
+// and that are scheduled to start between the input times, either of which
+// may be zero for an open range. They are returned in the order in which they
+// are scheduled to start, with the tasks that start as soon as possible
+// first.
+func (c *Client) GetQueuedTasks(environmentIDs []string, from time.Time, to time.Time) ([]*QueuedTask, error) {
+	queuedTasks := []*QueuedTask{}
+
+	deploymentsQuery := DeploymentsQuery{
+		Environments: environmentIDs,
+		TaskState:    string(TaskStateQueued),
+	}
+
+	for {
+		deployments, err := c.Deployments.Get(deploymentsQuery)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, deployment := range deployments.Items {
+			queuedTasks = append(queuedTasks, &QueuedTask{Deployment: deployment})
+		}
+
+		deploymentsQuery.Skip += len(deployments.Items)
+		if len(deployments.Items) == 0 || deploymentsQuery.Skip >= deployments.TotalResults {
+			break
+		}
+	}
+
+	runbookRunsQuery := RunbookRunsQuery{
+		Environments: environmentIDs,
+		TaskState:    string(TaskStateQueued),
+	}
+
+	for {
+		runbookRuns, err := c.RunbookRuns.Get(runbookRunsQuery)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, runbookRun := range runbookRuns.Items {
+			queuedTasks = append(queuedTasks, &QueuedTask{RunbookRun: runbookRun})
+		}
+
+		runbookRunsQuery.Skip += len(runbookRuns.Items)
+		if len(runbookRuns.Items) == 0 || runbookRunsQuery.Skip >= runbookRuns.TotalResults {
+			break
+		}
+	}
+
+	filtered := []*QueuedTask{}
+	for _, queuedTask := range queuedTasks {
+		if queuedTask.isQueuedBetween(from, to) {
+			filtered = append(filtered, queuedTask)
+		}
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		a := filtered[i].GetQueueTime()
+		b := filtered[j].GetQueueTime()
+		if a == nil || b == nil {
+			return a == nil && b != nil
+		}
+		return a.Before(*b)
+	})
+
+	return filtered, nil
+}
+
+// CheckScheduleConflicts returns the pairs of deployments and runbook runs
+// queued in the environments, or in every environment if none are given,
+// whose windows overlap, as FindScheduleConflicts does.
+func (c *Client) CheckScheduleConflicts(environmentIDs []string, from time.Time, to time.Time) ([]*ScheduleConflict, error) {
+	queuedTasks, err := c.GetQueuedTasks(environmentIDs, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return FindScheduleConflicts(queuedTasks), nil
+}
+
+// CancelQueuedTask cancels the task of the queued deployment or runbook run.
+// The task is checked first, and an error is returned without cancelling it
+// if it is no longer queued, such as when it started after it was listed.
+func (c *Client) CancelQueuedTask(queuedTask *QueuedTask) (*Task, error) {
+	if queuedTask == nil || isEmpty(queuedTask.GetTaskID()) {
+		return nil, createInvalidParameterError(OperationCancelQueuedTask, ParameterQueuedTask)
+	}
+
+	task, err := c.Tasks.GetByID(queuedTask.GetTaskID())
+	if err != nil {
+		return nil, err
+	}
+
+	if task.State != TaskStateQueued {
+		return nil, fmt.Errorf("%s: the task (%s) is no longer queued; it is %s", OperationCancelQueuedTask, queuedTask.GetTaskID(), task.State)
+	}
+
+	return c.Tasks.Cancel(queuedTask.GetTaskID())
+}
+
+// RescheduleQueuedTask cancels the task of the queued deployment or runbook
+// run and creates it again to start at the queue time and, if the queue time
+// expiry is not zero, no later than the expiry. The recreated deployment or
+// runbook run is validated before the task is cancelled, and the task is
+// only cancelled while it is still queued. If it cannot be created once the
+// task is cancelled, the error names the cancelled task.
+// The recreated deployment or runbook run is returned.
+func (c *Client) RescheduleQueuedTask(queuedTask *QueuedTask, queueTime time.Time, queueTimeExpiry time.Time) (*QueuedTask, error) {
+	if queuedTask == nil || (queuedTask.Deployment == nil && queuedTask.RunbookRun == nil) {
+		return nil, createInvalidParameterError(OperationRescheduleQueuedTask, ParameterQueuedTask)
+	}
+
+	if err := validateScheduleWindow(OperationRescheduleQueuedTask, queueTime, queueTimeExpiry); err != nil {
+		return nil, err
+	}
+
+	var expiry *time.Time
+	if !queueTimeExpiry.IsZero() {
+		expiry = &queueTimeExpiry
+	}
+
+	if queuedTask.Deployment != nil {
+		deployment := *queuedTask.Deployment
+		deployment.Created = nil
+		deployment.QueueTime = &queueTime
+		deployment.QueueTimeExpiry = expiry
+		deployment.TaskID = emptyString
+		deployment.resource = *newResource()
+
+		if err := deployment.Validate(); err != nil {
+			return nil, createValidationFailureError(OperationRescheduleQueuedTask, err)
+		}
+
+		if _, err := c.CancelQueuedTask(queuedTask); err != nil {
+			return nil, err
+		}
+
+		created, err := c.Deployments.Add(&deployment)
+		if err != nil {
+			return nil, createRescheduleError(queuedTask, err)
+		}
+
+		return &QueuedTask{Deployment: created}, nil
+	}
+
+	runbookRun := *queuedTask.RunbookRun
+	runbookRun.Created = nil
+	runbookRun.QueueTime = &queueTime
+	runbookRun.QueueTimeExpiry = expiry
+	runbookRun.TaskID = emptyString
+	runbookRun.resource = *newResource()
+
+	if err := runbookRun.Validate(); err != nil {
+		return nil, createValidationFailureError(OperationRescheduleQueuedTask, err)
+	}
+
+	if _, err := c.CancelQueuedTask(queuedTask); err != nil {
+		return nil, err
+	}
+
+	created, err := c.RunbookRuns.Add(&runbookRun)
+	if err != nil {
+		return nil, createRescheduleError(queuedTask, err)
+	}
+
+	return &QueuedTask{RunbookRun: created}, nil
+}
+
+// createRescheduleError returns the error of a queued task that was
+// cancelled but could not be created again.
+func createRescheduleError(queuedTask *QueuedTask, err error) error {
+	return fmt.Errorf("%s: the task (%s) was cancelled but could not be rescheduled: %v", OperationRescheduleQueuedTask, queuedTask.GetTaskID(), err)
+}
@@ -0,0 +1,172 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestQueuedDeployment(id string, environmentID string, queueTime *time.Time, queueTimeExpiry *time.Time) *QueuedTask {
+	deployment := NewDeployment(emptyString, environmentID, "Releases-1")
+	deployment.ID = id
+	deployment.QueueTime = queueTime
+	deployment.QueueTimeExpiry = queueTimeExpiry
+	return &QueuedTask{Deployment: deployment}
+}
+
+func TestFindScheduleConflicts(t *testing.T) {
+	at := func(hour int) *time.Time {
+		value := time.Date(2026, 10, 24, hour, 0, 0, 0, time.UTC)
+		return &value
+	}
+
+	runbookRun := NewRunbookRun("Runbooks-1", "RunbookSnapshots-1", "Environments-1")
+	runbookRun.ID = "RunbookRuns-1"
+	runbookRun.QueueTime = at(3)
+
+	queuedTasks := []*QueuedTask{
+		newTestQueuedDeployment("Deployments-1", "Environments-1", at(1), at(4)),
+		newTestQueuedDeployment("Deployments-2", "Environments-1", at(4), at(6)),
+		newTestQueuedDeployment("Deployments-3", "Environments-1", at(7), nil),
+		newTestQueuedDeployment("Deployments-4", "Environments-2", at(2), at(5)),
+		newTestQueuedDeployment("Deployments-5", "Environments-1", nil, nil),
+		{RunbookRun: runbookRun},
+	}
+
+	conflicts := FindScheduleConflicts(queuedTasks)
+	require.Len(t, conflicts, 2)
+	require.Equal(t, "Deployments-1", conflicts[0].First.GetID())
+	require.Equal(t, "Deployments-2", conflicts[0].Second.GetID())
+	require.Equal(t, "Deployments-1", conflicts[1].First.GetID())
+	require.Equal(t, "RunbookRuns-1", conflicts[1].Second.GetID())
+	require.EqualError(t, conflicts[1], "the windows of Deployments-1 and RunbookRuns-1 in the environment (Environments-1) overlap")
+}
+
+func TestQueuedTasks(t *testing.T) {
+	var cancelled []string
+	var created *Deployment
+	var deploymentQueries []string
+	failRunbookRun := false
+
+	base := createFakeSling(func(r *http.Request) (int, string) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/Spaces-1/deployments":
+			deploymentQueries = append(deploymentQueries, r.URL.RawQuery)
+			if r.URL.Query().Get("skip") == emptyString {
+				return http.StatusOK, `{"TotalResults":3,"Items":[
+					{"Id":"Deployments-1","ReleaseId":"Releases-1","EnvironmentId":"Environments-1","TaskId":"ServerTasks-1","QueueTime":"2026-10-24T02:00:00Z","QueueTimeExpiry":"2026-10-24T04:00:00Z","FormValues":{"Approver":"jo"}},
+					{"Id":"Deployments-2","ReleaseId":"Releases-1","EnvironmentId":"Environments-1","TaskId":"ServerTasks-2"}]}`
+			}
+			return http.StatusOK, `{"TotalResults":3,"Items":[
+				{"Id":"Deployments-3","ReleaseId":"Releases-1","EnvironmentId":"Environments-1","TaskId":"ServerTasks-3","QueueTime":"2026-10-25T02:00:00Z"}]}`
+		case r.Method == http.MethodGet && r.URL.Path == "/api/Spaces-1/runbookRuns":
+			require.Equal(t, "environments=Environments-1&taskState=Queued", r.URL.RawQuery)
+			return http.StatusOK, `{"TotalResults":1,"Items":[
+				{"Id":"RunbookRuns-1","EnvironmentId":"Environments-1","RunbookId":"Runbooks-1","RunbookSnapshotId":"RunbookSnapshots-1","TaskId":"ServerTasks-4","QueueTime":"2026-10-24T03:00:00Z"}]}`
+		case r.Method == http.MethodGet && r.URL.Path == "/api/tasks/ServerTasks-3":
+			return http.StatusOK, `{"Id":"ServerTasks-3","State":"Executing"}`
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/api/tasks/ServerTasks-"):
+			return http.StatusOK, `{"Id":"` + strings.TrimPrefix(r.URL.Path, "/api/tasks/") + `","State":"Queued"}`
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/cancel"):
+			cancelled = append(cancelled, r.URL.Path)
+			return http.StatusOK, `{"Id":"ServerTasks-1","State":"Canceled"}`
+		case r.Method == http.MethodPost && r.URL.Path == "/api/Spaces-1/deployments":
+			body, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			created = new(Deployment)
+			require.NoError(t, json.Unmarshal(body, created))
+			return http.StatusCreated, `{"Id":"Deployments-4","EnvironmentId":"Environments-1","TaskId":"ServerTasks-5","QueueTime":"2026-10-24T22:00:00Z"}`
+		case r.Method == http.MethodPost && r.URL.Path == "/api/Spaces-1/runbookRuns":
+			if failRunbookRun {
+				return http.StatusBadRequest, `{"ErrorMessage":"The runbook snapshot has been deleted"}`
+			}
+			return http.StatusCreated, `{"Id":"RunbookRuns-2","EnvironmentId":"Environments-1","TaskId":"ServerTasks-6"}`
+		}
+		return http.StatusNotFound, `{"ErrorMessage":"not found"}`
+	})
+
+	client := &Client{
+		Deployments: newDeploymentService(base, TestURIDeployments),
+		RunbookRuns: newRunbookRunService(base, TestURIRunbookRuns),
+		Tasks:       newTaskService(base, TestURITasks, TestURITaskTypes),
+	}
+
+	queuedTasks, err := client.GetQueuedTasks([]string{"Environments-1"}, time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"environments=Environments-1&taskState=Queued",
+		"environments=Environments-1&skip=2&taskState=Queued",
+	}, deploymentQueries)
+
+	ids := []string{}
+	for _, queuedTask := range queuedTasks {
+		ids = append(ids, queuedTask.GetID())
+	}
+	require.Equal(t, []string{"Deployments-2", "Deployments-1", "RunbookRuns-1", "Deployments-3"}, ids)
+
+	from := time.Date(2026, 10, 24, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 24, 23, 59, 0, 0, time.UTC)
+	queuedTasks, err = client.GetQueuedTasks([]string{"Environments-1"}, from, to)
+	require.NoError(t, err)
+	require.Len(t, queuedTasks, 2)
+	require.Equal(t, "Deployments-1", queuedTasks[0].GetID())
+	require.Equal(t, "RunbookRuns-1", queuedTasks[1].GetID())
+
+	conflicts, err := client.CheckScheduleConflicts([]string{"Environments-1"}, from, to)
+	require.NoError(t, err)
+	require.Len(t, conflicts, 1)
+
+	queueTime := time.Date(2026, 10, 24, 22, 0, 0, 0, time.UTC)
+	rescheduled, err := client.RescheduleQueuedTask(queuedTasks[0], queueTime, time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, "Deployments-4", rescheduled.GetID())
+	require.Equal(t, emptyString, created.GetID())
+	require.Equal(t, emptyString, created.TaskID)
+	require.Equal(t, queueTime, *created.QueueTime)
+	require.Nil(t, created.QueueTimeExpiry)
+	require.Equal(t, map[string]string{"Approver": "jo"}, created.FormValues)
+
+	rescheduled, err = client.RescheduleQueuedTask(queuedTasks[1], queueTime, queueTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, "RunbookRuns-2", rescheduled.GetID())
+	require.Equal(t, []string{"/api/tasks/ServerTasks-1/cancel", "/api/tasks/ServerTasks-4/cancel"}, cancelled)
+
+	// a task that started since it was listed is not cancelled
+	started := &QueuedTask{Deployment: &Deployment{EnvironmentID: String("Environments-1"), ReleaseID: String("Releases-1"), TaskID: "ServerTasks-3"}}
+	_, err = client.RescheduleQueuedTask(started, queueTime, time.Time{})
+	require.EqualError(t, err, "CancelQueuedTask: the task (ServerTasks-3) is no longer queued; it is Executing")
+	require.Len(t, cancelled, 2)
+
+	// an invalid task is not cancelled
+	invalid := &QueuedTask{RunbookRun: &RunbookRun{EnvironmentID: "Environments-1", TaskID: "ServerTasks-4"}}
+	_, err = client.RescheduleQueuedTask(invalid, queueTime, time.Time{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "validation failure in "+OperationRescheduleQueuedTask)
+	require.Len(t, cancelled, 2)
+
+	// a task that is cancelled but cannot be created again is named
+	failRunbookRun = true
+	_, err = client.RescheduleQueuedTask(queuedTasks[1], queueTime, time.Time{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "the task (ServerTasks-4) was cancelled but could not be rescheduled")
+	require.Len(t, cancelled, 3)
+
+	_, err = client.RescheduleQueuedTask(queuedTasks[0], queueTime, queueTime)
+	require.Equal(t, createInvalidParameterError(OperationRescheduleQueuedTask, ParameterQueueTimeExpiry), err)
+
+	_, err = client.CancelQueuedTask(&QueuedTask{})
+	require.Equal(t, createInvalidParameterError(OperationCancelQueuedTask, ParameterQueuedTask), err)
+
+	deployment, err := client.ScheduleDeployment(NewDeployment(emptyString, "Environments-1", "Releases-1"), queueTime, queueTime.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, "Deployments-4", deployment.GetID())
+	require.Equal(t, queueTime.Add(2*time.Hour), *created.QueueTimeExpiry)
+
+	_, err = client.ScheduleDeployment(NewDeployment(emptyString, "Environments-1", "Releases-1"), time.Time{}, time.Time{})
+	require.Equal(t, createInvalidParameterError(OperationScheduleDeployment, ParameterQueueTime), err)
+}
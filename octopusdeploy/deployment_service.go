@@ -2,6 +2,7 @@ package octopusdeploy
 
 import (
 	"github.com/dghubble/sling"
+	"github.com/google/go-querystring/query"
 )
 
 // deploymentService handles communication for any operations in the Octopus
@@ -52,6 +53,25 @@ func (s deploymentService) Add(resource *Deployment) (*Deployment, error) {
 	return resp.(*Deployment), nil
 }
 
+// Get returns a collection of deployments based on the criteria defined by
+// its input query parameter. If an error occurs, an empty collection is
+// returned along with the associated error.
+func (s deploymentService) Get(deploymentsQuery DeploymentsQuery) (*Deployments, error) {
+	v, _ := query.Values(deploymentsQuery)
+	path := s.BasePath
+	encodedQueryString := v.Encode()
+	if len(encodedQueryString) > 0 {
+		path += "?" + encodedQueryString
+	}
+
+	resp, err := apiGet(s.getClient(), new(Deployments), path)
+	if err != nil {
+		return &Deployments{}, err
+	}
+
+	return resp.(*Deployments), nil
+}
+
 // GetByID gets a deployment that matches the input ID. If one cannot be found,
 // it returns nil and an error.
 func (s deploymentService) GetByID(id string) (*Deployment, error) {
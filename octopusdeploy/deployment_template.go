@@ -0,0 +1,55 @@
+package octopusdeploy
+
+// DeploymentTemplate describes where a release can be deployed next: the
+// environments it can be promoted to, the tenants it can be promoted to and
+// their environments, and whether the deployment process or variables of the
+// project have changed since the release was created.
+type DeploymentTemplate struct {
+	DeploymentNotes              string                       `json:"DeploymentNotes,omitempty"`
+	IsDeploymentProcessModified  bool                         `json:"IsDeploymentProcessModified"`
+	IsLibraryVariableSetModified bool                         `json:"IsLibraryVariableSetModified"`
+	IsVariableSetModified        bool                         `json:"IsVariableSetModified"`
+	PromoteTo                    []*DeploymentPromotionTarget `json:"PromoteTo"`
+	TenantPromotions             []*DeploymentPromotionTenant `json:"TenantPromotions"`
+}
+
+// DeploymentPromotionTarget is an environment a release can be deployed to.
+// Its Preview link returns the preview of the deployment.
+type DeploymentPromotionTarget struct {
+	Name string `json:"Name,omitempty"`
+
+	resource
+}
+
+// DeploymentPromotionTenant is a tenant a release can be deployed to, with
+// the environments it can be deployed to for the tenant.
+type DeploymentPromotionTenant struct {
+	Name      string                       `json:"Name,omitempty"`
+	PromoteTo []*DeploymentPromotionTarget `json:"PromoteTo"`
+
+	resource
+}
+
+// getPromotionTarget returns the target of the template for the environment,
+// and the tenant if its ID is not empty, or nil if the release cannot be
+// deployed there.
+func (t *DeploymentTemplate) getPromotionTarget(environmentID string, tenantID string) *DeploymentPromotionTarget {
+	targets := t.PromoteTo
+	if !isEmpty(tenantID) {
+		targets = nil
+		for _, tenantPromotion := range t.TenantPromotions {
+			if tenantPromotion != nil && tenantPromotion.GetID() == tenantID {
+				targets = tenantPromotion.PromoteTo
+				break
+			}
+		}
+	}
+
+	for _, target := range targets {
+		if target != nil && target.GetID() == environmentID {
+			return target
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package octopusdeploy
+
+import "time"
+
+// DeploymentsCountedByWeek represents the number of deployments completed in
+// each week of a reporting period.
+type DeploymentsCountedByWeek struct {
+	Items      []*DeploymentCountByWeek `json:"Items"`
+	TotalCount int                      `json:"TotalCount"`
+}
+
+// DeploymentCountByWeek represents the number of deployments completed in a
+// single week.
+type DeploymentCountByWeek struct {
+	Count     int        `json:"Count"`
+	WeekEnd   *time.Time `json:"WeekEnd,omitempty"`
+	WeekStart *time.Time `json:"WeekStart,omitempty"`
+}
+
+// GetTotal returns the sum of the weekly counts.
+func (d *DeploymentsCountedByWeek) GetTotal() int {
+	total := 0
+	for _, item := range d.Items {
+		if item != nil {
+			total += item.Count
+		}
+	}
+	return total
+}
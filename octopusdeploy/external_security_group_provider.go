@@ -0,0 +1,12 @@
+package octopusdeploy
+
+// ExternalSecurityGroupProvider represents an authentication provider that
+// can look up external security groups, such as Active Directory.
+type ExternalSecurityGroupProvider struct {
+	Description         string `json:"Description,omitempty"`
+	IsRoleBased         bool   `json:"IsRoleBased,omitempty"`
+	Name                string `json:"Name,omitempty"`
+	SupportsGroupLookup bool   `json:"SupportsGroupLookup,omitempty"`
+
+	resource
+}
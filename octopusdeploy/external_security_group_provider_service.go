@@ -1,6 +1,9 @@
 package octopusdeploy
 
-import "github.com/dghubble/sling"
+import (
+	"github.com/dghubble/sling"
+	"github.com/fqjony/go-octopusdeploy/uritemplates"
+)
 
 type externalSecurityGroupProviderService struct {
 	service
@@ -11,3 +14,48 @@ func newExternalSecurityGroupProviderService(sling *sling.Sling, uriTemplate str
 		service: newService(ServiceExternalSecurityGroupProviderService, sling, uriTemplate),
 	}
 }
+
+// GetAll returns the external security group providers. If none can be
+// found or an error occurs, it returns an empty collection.
+func (s externalSecurityGroupProviderService) GetAll() ([]*ExternalSecurityGroupProvider, error) {
+	items := []*ExternalSecurityGroupProvider{}
+	if err := validateInternalState(s); err != nil {
+		return items, err
+	}
+
+	_, err := apiGet(s.getClient(), &items, trimTemplate(s.getPath()))
+	return items, err
+}
+
+// SearchGroups returns the external security groups of the provider with a
+// matching partial name.
+func (s externalSecurityGroupProviderService) SearchGroups(provider *ExternalSecurityGroupProvider, partialName string) ([]*NamedReferenceItem, error) {
+	if provider == nil {
+		return nil, createInvalidParameterError(OperationSearchGroups, ParameterSecurityGroupProvider)
+	}
+
+	if isEmpty(partialName) {
+		return nil, createInvalidParameterError(OperationSearchGroups, ParameterPartialName)
+	}
+
+	if !provider.SupportsGroupLookup || isEmpty(provider.Links[linkGroups]) {
+		return nil, createInvalidPathError(provider.Name)
+	}
+
+	template, err := uritemplates.Parse(provider.Links[linkGroups])
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]interface{})
+	values[ParameterPartialName] = partialName
+
+	path, err := template.Expand(values)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []*NamedReferenceItem{}
+	_, err = apiGet(s.getClient(), &items, path)
+	return items, err
+}
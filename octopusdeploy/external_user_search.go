@@ -0,0 +1,16 @@
+package octopusdeploy
+
+// ExternalUserSearchResults is the result of searching the identity providers
+// for users.
+type ExternalUserSearchResults struct {
+	Results []*ExternalUserSearchResult `json:"Results"`
+}
+
+// ExternalUserSearchResult lists the identities found by a single identity
+// provider.
+type ExternalUserSearchResult struct {
+	FailureMessage       string     `json:"FailureMessage,omitempty"`
+	Identities           []Identity `json:"Identities"`
+	IdentityProviderName string     `json:"IdentityProviderName,omitempty"`
+	IsSuccessful         bool       `json:"IsSuccessful"`
+}
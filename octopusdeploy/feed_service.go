@@ -199,6 +199,40 @@ func (s feedService) SearchPackages(feed IFeed, searchPackagesQuery ...SearchPac
 	return resp.(*PackageDescriptions), nil
 }
 
+// SearchPackageVersions returns the versions of a package in the feed that
+// match the input query, latest first.
+func (s feedService) SearchPackageVersions(feed IFeed, searchPackageVersionsQuery SearchPackageVersionsQuery) (*PackageVersions, error) {
+	if feed == nil {
+		return nil, createInvalidParameterError(OperationSearchPackageVersions, ParameterFeed)
+	}
+
+	if isEmpty(searchPackageVersionsQuery.PackageID) {
+		return nil, createInvalidParameterError(OperationSearchPackageVersions, ParameterPackageID)
+	}
+
+	template := feed.GetLinks()[linkSearchPackageVersionsTemplate]
+	if isEmpty(template) {
+		return nil, createInvalidPathError(s.getName())
+	}
+
+	uriTemplate, err := uritemplates.Parse(template)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := uriTemplate.Expand(searchPackageVersionsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiGet(s.getClient(), new(PackageVersions), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*PackageVersions), nil
+}
+
 // Update modifies a feed based on the one provided as input.
 func (s feedService) Update(feed IFeed) (IFeed, error) {
 	if feed == nil {
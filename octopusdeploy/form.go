@@ -1,6 +1,138 @@
 package octopusdeploy
 
+import (
+	"fmt"
+	"strings"
+)
+
 type Form struct {
 	Elements []*FormElement    `json:"Elements"`
 	Values   map[string]string `json:"Values,omitempty"`
 }
+
+// The display settings of the controls of prompted variables.
+const (
+	formControlTypeCheckbox = "Checkbox"
+	formControlTypeSelect   = "Select"
+	formDisplayControlType  = "Octopus.ControlType"
+	formDisplaySelectOption = "Octopus.SelectOptions"
+)
+
+// getElement returns the element of the form with the input name, or nil if
+// there is none.
+func (f *Form) getElement(name string) *FormElement {
+	if f == nil {
+		return nil
+	}
+
+	for _, element := range f.Elements {
+		if element != nil && element.Name == name {
+			return element
+		}
+	}
+
+	return nil
+}
+
+// getControlField returns the value of a string field of the control, or an
+// empty string if it has none.
+func getControlField(control Control, name string) string {
+	fields, ok := control.(map[string]interface{})
+	if !ok {
+		return emptyString
+	}
+
+	value, _ := fields[name].(string)
+	return value
+}
+
+// getControlDisplaySetting returns the value of a display setting of the
+// control of a prompted variable, or an empty string if it has none.
+func getControlDisplaySetting(control Control, name string) string {
+	fields, ok := control.(map[string]interface{})
+	if !ok {
+		return emptyString
+	}
+
+	settings, ok := fields["DisplaySettings"].(map[string]interface{})
+	if !ok {
+		return emptyString
+	}
+
+	value, _ := settings[name].(string)
+	return value
+}
+
+// getLabel returns the label of the element, falling back to the name of its
+// control and then its own name.
+func (e *FormElement) getLabel() string {
+	for _, field := range []string{"Label", "Name"} {
+		if label := getControlField(e.Control, field); !isEmpty(label) {
+			return label
+		}
+	}
+
+	return e.Name
+}
+
+// getSelectOptions returns the values a select control accepts. Options are
+// defined one per line as value|label.
+func getSelectOptions(control Control) []string {
+	options := []string{}
+	for _, line := range strings.Split(getControlDisplaySetting(control, formDisplaySelectOption), "\n") {
+		value := strings.TrimSpace(strings.SplitN(line, "|", 2)[0])
+		if !isEmpty(value) {
+			options = append(options, value)
+		}
+	}
+
+	return options
+}
+
+// ValidateValues checks values submitted for the form, keyed by the names of
+// its elements: every value must belong to an element, required elements
+// must have a value unless the form provides one, select controls must be
+// given one of their options, and checkboxes True or False.
+func (f *Form) ValidateValues(values map[string]string) error {
+	for name := range values {
+		if f.getElement(name) == nil {
+			return fmt.Errorf("the form has no element (%s)", name)
+		}
+	}
+
+	if f == nil {
+		return nil
+	}
+
+	for _, element := range f.Elements {
+		if element == nil {
+			continue
+		}
+
+		value, ok := values[element.Name]
+		if !ok {
+			value = f.Values[element.Name]
+		}
+
+		if isEmpty(value) {
+			if element.IsValueRequired != nil && *element.IsValueRequired {
+				return fmt.Errorf("a value is required for %s", element.getLabel())
+			}
+			continue
+		}
+
+		switch getControlDisplaySetting(element.Control, formDisplayControlType) {
+		case formControlTypeCheckbox:
+			if !strings.EqualFold(value, "True") && !strings.EqualFold(value, "False") {
+				return fmt.Errorf("%s is not a valid value for %s; valid values are True, False", value, element.getLabel())
+			}
+		case formControlTypeSelect:
+			options := getSelectOptions(element.Control)
+			if len(options) > 0 && !ValidateStringInSlice(value, options) {
+				return fmt.Errorf("%s is not a valid value for %s; valid values are %s", value, element.getLabel(), strings.Join(options, ", "))
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testPromptedVariablesForm = `{"Elements":[
+	{"Name":"Variables-1","Control":{"Type":"VariableValue","Name":"Approver","Label":"Change approver","DisplaySettings":{"Octopus.ControlType":"SingleLineText"}},"IsValueRequired":true},
+	{"Name":"Variables-2","Control":{"Type":"VariableValue","Name":"Region","DisplaySettings":{"Octopus.ControlType":"Select","Octopus.SelectOptions":"eu|Europe\nus|United States"}},"IsValueRequired":true},
+	{"Name":"Variables-3","Control":{"Type":"VariableValue","Name":"RunMigrations","DisplaySettings":{"Octopus.ControlType":"Checkbox"}},"IsValueRequired":false}],
+	"Values":{"Variables-2":"eu"}}`
+
+func TestFormValidateValues(t *testing.T) {
+	form := new(Form)
+	require.NoError(t, json.Unmarshal([]byte(testPromptedVariablesForm), form))
+
+	testCases := []struct {
+		name   string
+		values map[string]string
+		err    string
+	}{
+		{"Valid", map[string]string{"Variables-1": "jo", "Variables-2": "us", "Variables-3": "true"}, emptyString},
+		{"DefaultValue", map[string]string{"Variables-1": "jo"}, emptyString},
+		{"MissingRequired", map[string]string{"Variables-2": "us"}, "a value is required for Change approver"},
+		{"EmptyRequired", map[string]string{"Variables-1": "jo", "Variables-2": emptyString}, "a value is required for Region"},
+		{"InvalidOption", map[string]string{"Variables-1": "jo", "Variables-2": "apac"}, "apac is not a valid value for Region; valid values are eu, us"},
+		{"InvalidCheckbox", map[string]string{"Variables-1": "jo", "Variables-3": "yes"}, "yes is not a valid value for RunMigrations; valid values are True, False"},
+		{"UnknownElement", map[string]string{"Variables-1": "jo", "Variables-4": "x"}, "the form has no element (Variables-4)"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := form.ValidateValues(tc.values)
+			if isEmpty(tc.err) {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.err)
+			}
+		})
+	}
+
+	var noForm *Form
+	require.NoError(t, noForm.ValidateValues(nil))
+	require.EqualError(t, noForm.ValidateValues(map[string]string{"Variables-1": "jo"}), "the form has no element (Variables-1)")
+}
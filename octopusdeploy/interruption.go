@@ -1,6 +1,9 @@
 package octopusdeploy
 
 import (
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 )
 
@@ -35,3 +38,98 @@ func NewInterruption() *Interruption {
 
 const ManualInterverventionApprove = "Proceed"
 const ManualInterventionDecline = "Abort"
+
+// The names of the form fields of manual intervention and guided failure
+// interruptions.
+const (
+	interruptionFormGuidance = "Guidance"
+	interruptionFormNotes    = "Notes"
+	interruptionFormResult   = "Result"
+)
+
+// getFormElement returns the element of the form with the input name, or nil
+// if there is none.
+func (i *Interruption) getFormElement(name string) *FormElement {
+	return i.Form.getElement(name)
+}
+
+// IsGuidedFailure returns true if the interruption asks for guidance after a
+// step failed.
+func (i *Interruption) IsGuidedFailure() bool {
+	return i.getFormElement(interruptionFormGuidance) != nil
+}
+
+// IsManualIntervention returns true if the interruption asks for a manual
+// intervention step to be approved or aborted.
+func (i *Interruption) IsManualIntervention() bool {
+	return i.getFormElement(interruptionFormResult) != nil
+}
+
+// getControlValues returns the values of the buttons of a button group
+// control, or nil if the control is not a button group.
+func getControlValues(control Control) []string {
+	fields, ok := control.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	buttons, ok := fields["Buttons"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	values := []string{}
+	for _, button := range buttons {
+		if buttonFields, ok := button.(map[string]interface{}); ok {
+			if value, ok := buttonFields["Value"].(string); ok {
+				values = append(values, value)
+			}
+		}
+	}
+
+	return values
+}
+
+// ValidateSubmitRequest checks the request against the form of the
+// interruption and returns an error if the interruption is no longer
+// pending, the request sets a field that the form does not have or a value
+// that its buttons do not offer, or a required field is not set.
+func (i *Interruption) ValidateSubmitRequest(r *InterruptionSubmitRequest) error {
+	if r == nil {
+		return createRequiredParameterIsEmptyOrNilError(ParameterSubmitRequest)
+	}
+
+	if !i.IsPending {
+		return fmt.Errorf("the interruption (%s) is no longer pending", i.Title)
+	}
+
+	values := r.getValues()
+
+	names := []string{}
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		element := i.getFormElement(name)
+		if element == nil {
+			return fmt.Errorf("the form of the interruption (%s) has no %s field", i.Title, name)
+		}
+
+		options := getControlValues(element.Control)
+		if len(options) > 0 && !ValidateStringInSlice(values[name], options) {
+			return fmt.Errorf("%s is not a valid value for the %s field of the interruption (%s); valid values are %s", values[name], name, i.Title, strings.Join(options, ", "))
+		}
+	}
+
+	if i.Form != nil {
+		for _, element := range i.Form.Elements {
+			if element != nil && element.IsValueRequired != nil && *element.IsValueRequired && isEmpty(values[element.Name]) {
+				return fmt.Errorf("the %s field of the interruption (%s) is required", element.Name, i.Title)
+			}
+		}
+	}
+
+	return nil
+}
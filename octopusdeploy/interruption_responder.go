@@ -0,0 +1,223 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"time"
+)
+
+// InterruptionPolicy decides how an InterruptionResponder responds to the
+// interruptions of a task. For example, to retry a failed step up to two
+// times and then fail the deployment:
+//
+//	InterruptionPolicy{GuidedFailureGuidance: GuidedFailureGuidanceFail, MaximumRetries: 2}
+type InterruptionPolicy struct {
+	// GuidedFailureGuidance is given in response to a guided failure once
+	// the failed step has been retried MaximumRetries times. If empty, the
+	// interruption is left pending.
+	GuidedFailureGuidance GuidedFailureGuidance
+
+	// ManualInterventionResult is submitted in response to a manual
+	// intervention: ManualInterverventionApprove or
+	// ManualInterventionDecline. If empty, the interruption is left pending.
+	ManualInterventionResult string
+
+	// MaximumRetries is the number of times a failed step is retried before
+	// the guidance is given.
+	MaximumRetries int
+
+	// Notes are submitted with every response.
+	Notes string
+}
+
+// InterruptionResponse records a response submitted by an
+// InterruptionResponder.
+type InterruptionResponse struct {
+	CorrelationID  string                `json:"CorrelationId,omitempty"`
+	Guidance       GuidedFailureGuidance `json:"Guidance,omitempty"`
+	InterruptionID string                `json:"InterruptionId"`
+	Result         string                `json:"Result,omitempty"`
+	Title          string                `json:"Title"`
+}
+
+// InterruptionResponder takes responsibility for, and responds to, the
+// pending interruptions of tasks according to a policy. It counts the
+// retries of each failed step, identified by the correlation ID of its
+// interruptions, across calls.
+type InterruptionResponder struct {
+	client  *Client
+	policy  InterruptionPolicy
+	retries map[string]int
+}
+
+// NewInterruptionResponder returns a responder that uses the client to
+// respond to interruptions according to the policy.
+func NewInterruptionResponder(client *Client, policy InterruptionPolicy) *InterruptionResponder {
+	return &InterruptionResponder{
+		client:  client,
+		policy:  policy,
+		retries: map[string]int{},
+	}
+}
+
+// getRetryKey returns the key the retries of the failed step of the
+// interruption are counted by.
+func getRetryKey(interruption *Interruption) string {
+	if isEmpty(interruption.CorrelationID) {
+		return interruption.GetID()
+	}
+	return interruption.CorrelationID
+}
+
+// getRequest returns the request the policy submits in response to the
+// interruption, or nil if it is to be left pending.
+func (r *InterruptionResponder) getRequest(interruption *Interruption) *InterruptionSubmitRequest {
+	switch {
+	case interruption.IsGuidedFailure():
+		if r.retries[getRetryKey(interruption)] < r.policy.MaximumRetries {
+			return NewGuidedFailureRequest(GuidedFailureGuidanceRetry, r.policy.Notes)
+		}
+
+		if isEmpty(string(r.policy.GuidedFailureGuidance)) {
+			return nil
+		}
+
+		return NewGuidedFailureRequest(r.policy.GuidedFailureGuidance, r.policy.Notes)
+	case interruption.IsManualIntervention():
+		if isEmpty(r.policy.ManualInterventionResult) {
+			return nil
+		}
+
+		return &InterruptionSubmitRequest{
+			Notes:  r.policy.Notes,
+			Result: r.policy.ManualInterventionResult,
+		}
+	}
+
+	return nil
+}
+
+// Respond responds to the pending interruptions of the task and returns the
+// responses that were submitted.
+func (r *InterruptionResponder) Respond(taskID string) ([]*InterruptionResponse, error) {
+	responses := []*InterruptionResponse{}
+
+	if isEmpty(taskID) {
+		return responses, createInvalidParameterError(OperationRespondToInterruptions, ParameterTaskID)
+	}
+
+	interruptions, err := r.client.Interruptions.Get(InterruptionsQuery{
+		PendingOnly: true,
+		Regarding:   taskID,
+	})
+	if err != nil {
+		return responses, err
+	}
+
+	for _, interruption := range interruptions.Items {
+		if interruption == nil || !interruption.IsPending {
+			continue
+		}
+
+		request := r.getRequest(interruption)
+		if request == nil {
+			continue
+		}
+
+		if _, err := r.client.RespondToInterruption(interruption, request); err != nil {
+			return responses, err
+		}
+
+		if request.Guidance == GuidedFailureGuidanceRetry {
+			r.retries[getRetryKey(interruption)]++
+		}
+
+		responses = append(responses, &InterruptionResponse{
+			CorrelationID:  interruption.CorrelationID,
+			Guidance:       request.Guidance,
+			InterruptionID: interruption.GetID(),
+			Result:         request.Result,
+			Title:          interruption.Title,
+		})
+	}
+
+	return responses, nil
+}
+
+// Watch responds to the interruptions of the task until it completes, and
+// returns the completed task and the responses that were submitted. If the
+// timeout is not zero and elapses first, the task is returned along with an
+// error.
+func (r *InterruptionResponder) Watch(taskID string, timeout time.Duration) (*Task, []*InterruptionResponse, error) {
+	responses := []*InterruptionResponse{}
+
+	if isEmpty(taskID) {
+		return nil, responses, createInvalidParameterError(OperationWatchInterruptions, ParameterTaskID)
+	}
+
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	for {
+		task, err := r.client.Tasks.GetByID(taskID)
+		if err != nil {
+			return nil, responses, err
+		}
+
+		if task.IsCompleted {
+			return task, responses, nil
+		}
+
+		if task.HasPendingInterruptions {
+			submitted, err := r.Respond(taskID)
+			responses = append(responses, submitted...)
+			if err != nil {
+				return task, responses, err
+			}
+		}
+
+		wait := taskPollInterval
+		if !deadline.IsZero() {
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				return task, responses, createTimeoutError(OperationWatchInterruptions, timeout)
+			}
+
+			if remaining < wait {
+				wait = remaining
+			}
+		}
+
+		time.Sleep(wait)
+	}
+}
+
+// RespondToInterruption validates the request against the form of the
+// interruption, takes responsibility for the interruption if the current
+// user does not have it, and submits the request.
+func (c *Client) RespondToInterruption(interruption *Interruption, request *InterruptionSubmitRequest) (*Interruption, error) {
+	if interruption == nil {
+		return nil, createInvalidParameterError(OperationRespondToInterruption, ParameterInterruption)
+	}
+
+	if request == nil {
+		return nil, createInvalidParameterError(OperationRespondToInterruption, ParameterSubmitRequest)
+	}
+
+	if err := interruption.ValidateSubmitRequest(request); err != nil {
+		return nil, createValidationFailureError(OperationRespondToInterruption, err)
+	}
+
+	if !interruption.HasResponsibility {
+		if !interruption.CanTakeResponsibility {
+			return nil, fmt.Errorf("%s: the current user cannot take responsibility for the interruption (%s)", OperationRespondToInterruption, interruption.Title)
+		}
+
+		if _, err := c.Interruptions.TakeResponsibility(interruption); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.Interruptions.Submit(interruption, request)
+}
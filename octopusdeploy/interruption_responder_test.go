@@ -0,0 +1,161 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testGuidedFailureForm = `{"Elements":[
+	{"Name":"Guidance","Control":{"Type":"SubmitButtonGroup","Buttons":[{"Text":"Fail","Value":"Fail"},{"Text":"Retry","Value":"Retry"},{"Text":"Ignore","Value":"Ignore"},{"Text":"Exclude machine","Value":"Exclude"}]},"IsValueRequired":true},
+	{"Name":"Notes","Control":{"Type":"TextArea"},"IsValueRequired":false}]}`
+
+const testManualInterventionForm = `{"Elements":[
+	{"Name":"Instructions","Control":{"Type":"Paragraph","Text":"Check the smoke tests"},"IsValueRequired":false},
+	{"Name":"Notes","Control":{"Type":"TextArea"},"IsValueRequired":false},
+	{"Name":"Result","Control":{"Type":"SubmitButtonGroup","Buttons":[{"Text":"Proceed","Value":"Proceed"},{"Text":"Abort","Value":"Abort"}]},"IsValueRequired":true}]}`
+
+func newTestInterruption(t *testing.T, form string) *Interruption {
+	interruption := NewInterruption()
+	interruption.ID = "Interruptions-1"
+	interruption.IsPending = true
+	interruption.Title = "Deploy web"
+	require.NoError(t, json.Unmarshal([]byte(form), &interruption.Form))
+	return interruption
+}
+
+func TestInterruptionValidateSubmitRequest(t *testing.T) {
+	guidedFailure := newTestInterruption(t, testGuidedFailureForm)
+	require.True(t, guidedFailure.IsGuidedFailure())
+	require.False(t, guidedFailure.IsManualIntervention())
+
+	require.NoError(t, guidedFailure.ValidateSubmitRequest(NewGuidedFailureRequest(GuidedFailureGuidanceExclude, "bad machine")))
+	require.EqualError(t, guidedFailure.ValidateSubmitRequest(NewGuidedFailureRequest(GuidedFailureGuidance("Abort"), emptyString)),
+		"Abort is not a valid value for the Guidance field of the interruption (Deploy web); valid values are Fail, Retry, Ignore, Exclude")
+	require.EqualError(t, guidedFailure.ValidateSubmitRequest(NewManualInterventionApproveRequest(emptyString)),
+		"the form of the interruption (Deploy web) has no Result field")
+	require.EqualError(t, guidedFailure.ValidateSubmitRequest(&InterruptionSubmitRequest{Notes: "notes"}),
+		"the Guidance field of the interruption (Deploy web) is required")
+	require.Error(t, guidedFailure.ValidateSubmitRequest(nil))
+
+	manualIntervention := newTestInterruption(t, testManualInterventionForm)
+	require.True(t, manualIntervention.IsManualIntervention())
+	require.NoError(t, manualIntervention.ValidateSubmitRequest(NewManualInterventionApproveRequest("looks good")))
+	require.NoError(t, manualIntervention.ValidateSubmitRequest(NewManualInterventionAbortRequest(emptyString)))
+	require.EqualError(t, manualIntervention.ValidateSubmitRequest(NewGuidedFailureRequest(GuidedFailureGuidanceRetry, emptyString)),
+		"the form of the interruption (Deploy web) has no Guidance field")
+
+	manualIntervention.IsPending = false
+	require.EqualError(t, manualIntervention.ValidateSubmitRequest(NewManualInterventionApproveRequest(emptyString)),
+		"the interruption (Deploy web) is no longer pending")
+}
+
+func TestInterruptionResponderWatch(t *testing.T) {
+	defer setTaskPollInterval(time.Millisecond)()
+
+	interruptionCount := 1
+	responsibilityTaken := 0
+	submitted := []map[string]interface{}{}
+
+	base := createFakeSling(func(r *http.Request) (int, string) {
+		switch {
+		case r.URL.Path == "/api/tasks/ServerTasks-1":
+			if len(submitted) == 3 {
+				return http.StatusOK, `{"Id":"ServerTasks-1","State":"Failed","IsCompleted":true}`
+			}
+			return http.StatusOK, `{"Id":"ServerTasks-1","State":"Executing","HasPendingInterruptions":true}`
+		case r.URL.Path == "/api/Spaces-1/interruptions":
+			require.Equal(t, "pendingOnly=true&regarding=ServerTasks-1", r.URL.RawQuery)
+			id := fmt.Sprintf("Interruptions-%d", interruptionCount)
+			return http.StatusOK, `{"Items":[{"Id":"` + id + `","Title":"Deploy web","CorrelationId":"ServerTasks-1_step1","IsPending":true,"CanTakeResponsibility":true,
+				"Form":` + testGuidedFailureForm + `,
+				"Links":{"Responsible":"/api/Spaces-1/interruptions/` + id + `/responsible","Submit":"/api/Spaces-1/interruptions/` + id + `/submit"}}]}`
+		case r.Method == http.MethodPut:
+			responsibilityTaken++
+			return http.StatusOK, `{"Id":"Users-1","Username":"deployer"}`
+		case r.Method == http.MethodPost:
+			body, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			values := map[string]interface{}{}
+			require.NoError(t, json.Unmarshal(body, &values))
+			submitted = append(submitted, values)
+			interruptionCount++
+			return http.StatusOK, `{"Id":"Interruptions-1","IsPending":false}`
+		}
+		return http.StatusNotFound, `{"ErrorMessage":"not found"}`
+	})
+
+	client := &Client{
+		Interruptions: newInterruptionService(base, TestURIInterruptions),
+		Tasks:         newTaskService(base, TestURITasks, TestURITaskTypes),
+	}
+
+	responder := NewInterruptionResponder(client, InterruptionPolicy{
+		GuidedFailureGuidance: GuidedFailureGuidanceFail,
+		MaximumRetries:        2,
+		Notes:                 "automated",
+	})
+
+	task, responses, err := responder.Watch("ServerTasks-1", time.Second)
+	require.NoError(t, err)
+	require.Equal(t, TaskStateFailed, task.State)
+	require.Equal(t, 3, responsibilityTaken)
+
+	require.Len(t, responses, 3)
+	require.Equal(t, GuidedFailureGuidanceRetry, responses[0].Guidance)
+	require.Equal(t, GuidedFailureGuidanceRetry, responses[1].Guidance)
+	require.Equal(t, GuidedFailureGuidanceFail, responses[2].Guidance)
+	require.Equal(t, "Interruptions-3", responses[2].InterruptionID)
+
+	require.Equal(t, "Retry", submitted[0]["Guidance"])
+	require.Equal(t, "automated", submitted[0]["Notes"])
+	require.Equal(t, "Fail", submitted[2]["Guidance"])
+
+	_, _, err = responder.Watch(emptyString, time.Second)
+	require.Equal(t, createInvalidParameterError(OperationWatchInterruptions, ParameterTaskID), err)
+}
+
+func TestInterruptionResponderRespondFailedSubmit(t *testing.T) {
+	base := createFakeSling(func(r *http.Request) (int, string) {
+		switch {
+		case r.URL.Path == "/api/Spaces-1/interruptions":
+			return http.StatusOK, `{"Items":[{"Id":"Interruptions-1","Title":"Deploy web","CorrelationId":"ServerTasks-1_step1","IsPending":true,"HasResponsibility":true,
+				"Form":` + testGuidedFailureForm + `,
+				"Links":{"Submit":"/api/Spaces-1/interruptions/Interruptions-1/submit"}}]}`
+		case r.Method == http.MethodPost:
+			return http.StatusInternalServerError, `{"ErrorMessage":"unavailable"}`
+		}
+		return http.StatusNotFound, `{"ErrorMessage":"not found"}`
+	})
+
+	client := &Client{
+		Interruptions: newInterruptionService(base, TestURIInterruptions),
+	}
+
+	responder := NewInterruptionResponder(client, InterruptionPolicy{MaximumRetries: 1})
+
+	// a retry that could not be submitted is not counted
+	responses, err := responder.Respond("ServerTasks-1")
+	require.Error(t, err)
+	require.Empty(t, responses)
+	require.Zero(t, responder.retries["ServerTasks-1_step1"])
+}
+
+func TestRespondToInterruption(t *testing.T) {
+	client := &Client{}
+
+	interruption := newTestInterruption(t, testManualInterventionForm)
+	_, err := client.RespondToInterruption(interruption, NewGuidedFailureRequest(GuidedFailureGuidanceRetry, emptyString))
+	require.EqualError(t, err, "validation failure in RespondToInterruption; the form of the interruption (Deploy web) has no Guidance field")
+
+	_, err = client.RespondToInterruption(interruption, NewManualInterventionApproveRequest(emptyString))
+	require.EqualError(t, err, "RespondToInterruption: the current user cannot take responsibility for the interruption (Deploy web)")
+
+	_, err = client.RespondToInterruption(nil, NewManualInterventionApproveRequest(emptyString))
+	require.Equal(t, createInvalidParameterError(OperationRespondToInterruption, ParameterInterruption), err)
+}
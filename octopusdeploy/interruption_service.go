@@ -2,6 +2,7 @@ package octopusdeploy
 
 import (
 	"github.com/dghubble/sling"
+	"github.com/google/go-querystring/query"
 )
 
 type interruptionService struct {
@@ -32,6 +33,30 @@ func (s interruptionService) getPagedResponse(path string) ([]*Interruption, err
 	return resources, nil
 }
 
+// Get returns a collection of interruptions based on the criteria defined by
+// its input query parameter. If an error occurs, an empty collection is
+// returned along with the associated error.
+func (s interruptionService) Get(interruptionsQuery InterruptionsQuery) (*Interruptions, error) {
+	err := validateInternalState(s)
+	if err != nil {
+		return &Interruptions{}, err
+	}
+
+	v, _ := query.Values(interruptionsQuery)
+	path := s.BasePath
+	encodedQueryString := v.Encode()
+	if len(encodedQueryString) > 0 {
+		path += "?" + encodedQueryString
+	}
+
+	resp, err := apiGet(s.getClient(), new(Interruptions), path)
+	if err != nil {
+		return &Interruptions{}, err
+	}
+
+	return resp.(*Interruptions), nil
+}
+
 // GetByID returns the interruption that matches the input ID. If one cannot be
 // found, it returns nil and an error.
 func (s interruptionService) GetByID(id string) (*Interruption, error) {
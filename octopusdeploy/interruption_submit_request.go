@@ -1,11 +1,65 @@
 package octopusdeploy
 
+// GuidedFailureGuidance is the guidance given in response to a guided
+// failure interruption.
+type GuidedFailureGuidance string
+
+const (
+	GuidedFailureGuidanceExclude = GuidedFailureGuidance("Exclude")
+	GuidedFailureGuidanceFail    = GuidedFailureGuidance("Fail")
+	GuidedFailureGuidanceIgnore  = GuidedFailureGuidance("Ignore")
+	GuidedFailureGuidanceRetry   = GuidedFailureGuidance("Retry")
+)
+
 type InterruptionSubmitRequest struct {
-	Instructions string `json:"Instructions"`
-	Notes        string `json:"Notes"`
-	Result       string `json:"Result"`
+	Guidance     GuidedFailureGuidance `json:"Guidance,omitempty"`
+	Instructions string                `json:"Instructions"`
+	Notes        string                `json:"Notes"`
+	Result       string                `json:"Result"`
 }
 
 func NewInterruptionSubmitRequest() *InterruptionSubmitRequest {
 	return &InterruptionSubmitRequest{}
 }
+
+// NewManualInterventionApproveRequest returns a request that approves a
+// manual intervention so that the deployment proceeds.
+func NewManualInterventionApproveRequest(notes string) *InterruptionSubmitRequest {
+	return &InterruptionSubmitRequest{
+		Notes:  notes,
+		Result: ManualInterverventionApprove,
+	}
+}
+
+// NewManualInterventionAbortRequest returns a request that declines a manual
+// intervention so that the deployment is aborted.
+func NewManualInterventionAbortRequest(notes string) *InterruptionSubmitRequest {
+	return &InterruptionSubmitRequest{
+		Notes:  notes,
+		Result: ManualInterventionDecline,
+	}
+}
+
+// NewGuidedFailureRequest returns a request that gives guidance in response
+// to a guided failure.
+func NewGuidedFailureRequest(guidance GuidedFailureGuidance, notes string) *InterruptionSubmitRequest {
+	return &InterruptionSubmitRequest{
+		Guidance: guidance,
+		Notes:    notes,
+	}
+}
+
+// getValues returns the form values submitted by the request.
+func (r *InterruptionSubmitRequest) getValues() map[string]string {
+	values := map[string]string{}
+	if !isEmpty(string(r.Guidance)) {
+		values[interruptionFormGuidance] = string(r.Guidance)
+	}
+	if !isEmpty(r.Notes) {
+		values[interruptionFormNotes] = r.Notes
+	}
+	if !isEmpty(r.Result) {
+		values[interruptionFormResult] = r.Result
+	}
+	return values
+}
@@ -0,0 +1,42 @@
+package octopusdeploy
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// LetsEncryptConfiguration represents the settings the Octopus server uses to
+// request and renew its SSL certificate from Let's Encrypt.
+type LetsEncryptConfiguration struct {
+	AcceptLetsEncryptTermsOfService bool       `json:"AcceptLetsEncryptTermsOfService"`
+	CertificateExpiry               *time.Time `json:"CertificateExpiry,omitempty"`
+	CertificateThumbprint           string     `json:"CertificateThumbprint,omitempty"`
+	DNSName                         string     `json:"DnsName,omitempty"`
+	Enabled                         bool       `json:"Enabled"`
+	HTTPSPort                       int        `json:"HttpsPort,omitempty" validate:"gte=0,lte=65535"`
+	IPAddress                       string     `json:"IPAddress,omitempty"`
+	Path                            string     `json:"Path,omitempty"`
+	RegistrationEmailAddress        string     `json:"RegistrationEmailAddress,omitempty" validate:"omitempty,email"`
+
+	resource
+}
+
+// NewLetsEncryptConfiguration initializes Let's Encrypt settings with a DNS
+// name and registration email address.
+func NewLetsEncryptConfiguration(dnsName string, registrationEmailAddress string) *LetsEncryptConfiguration {
+	return &LetsEncryptConfiguration{
+		DNSName:                  dnsName,
+		HTTPSPort:                443,
+		IPAddress:                "0.0.0.0",
+		Path:                     "/",
+		RegistrationEmailAddress: registrationEmailAddress,
+		resource:                 *newResource(),
+	}
+}
+
+// Validate checks the state of the Let's Encrypt configuration and returns an
+// error if invalid.
+func (l *LetsEncryptConfiguration) Validate() error {
+	return validator.New().Struct(l)
+}
@@ -11,3 +11,38 @@ func newLetsEncryptConfigurationService(sling *sling.Sling, uriTemplate string)
 		service: newService(ServiceLetsEncryptConfigurationService, sling, uriTemplate),
 	}
 }
+
+// Get returns the Let's Encrypt configuration of the Octopus server.
+func (s letsEncryptConfigurationService) Get() (*LetsEncryptConfiguration, error) {
+	path, err := getPath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiGet(s.getClient(), new(LetsEncryptConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*LetsEncryptConfiguration), nil
+}
+
+// Update modifies the Let's Encrypt configuration of the Octopus server based
+// on the one provided as input.
+func (s letsEncryptConfigurationService) Update(letsEncryptConfiguration *LetsEncryptConfiguration) (*LetsEncryptConfiguration, error) {
+	if letsEncryptConfiguration == nil {
+		return nil, createInvalidParameterError(OperationUpdate, ParameterLetsEncryptConfiguration)
+	}
+
+	path, err := getUpdatePath(s, letsEncryptConfiguration)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiUpdate(s.getClient(), letsEncryptConfiguration, new(LetsEncryptConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*LetsEncryptConfiguration), nil
+}
@@ -0,0 +1,63 @@
+package octopusdeploy
+
+import "time"
+
+// License represents the license installed on an Octopus server.
+type License struct {
+	LicenseText string `json:"LicenseText,omitempty"`
+
+	resource
+}
+
+// LicenseStatus represents the compliance status of the license installed on
+// an Octopus server.
+type LicenseStatus struct {
+	ComplianceSummary         string                `json:"ComplianceSummary,omitempty"`
+	DaysToEffectiveExpiryDate int                   `json:"DaysToEffectiveExpiryDate"`
+	EffectiveEdition          string                `json:"EffectiveEdition,omitempty"`
+	EffectiveExpiryDate       *time.Time            `json:"EffectiveExpiryDate,omitempty"`
+	HostingEnvironment        string                `json:"HostingEnvironment,omitempty"`
+	IsCompliant               bool                  `json:"IsCompliant"`
+	Limits                    []*LicenseLimitStatus `json:"Limits"`
+	Messages                  []*LicenseMessage     `json:"Messages"`
+	PermissionsMode           string                `json:"PermissionsMode,omitempty"`
+
+	resource
+}
+
+// LicenseLimitStatus represents the usage of a limited resource, such as
+// projects, machines or users, against the limit set by the license.
+type LicenseLimitStatus struct {
+	CurrentUsage              int    `json:"CurrentUsage"`
+	EffectiveLimit            int    `json:"EffectiveLimit"`
+	EffectiveLimitDescription string `json:"EffectiveLimitDescription,omitempty"`
+	IsUnlimited               bool   `json:"IsUnlimited"`
+	Name                      string `json:"Name"`
+}
+
+// LicenseMessage represents a message reported about the license.
+type LicenseMessage struct {
+	Message  string `json:"Message"`
+	Severity string `json:"Severity"`
+}
+
+// GetUsage returns the current usage as a fraction of the limit. It returns
+// zero for unlimited resources.
+func (l *LicenseLimitStatus) GetUsage() float64 {
+	if l.IsUnlimited || l.EffectiveLimit <= 0 {
+		return 0
+	}
+	return float64(l.CurrentUsage) / float64(l.EffectiveLimit)
+}
+
+// IsNearLimit returns true if the current usage is at or above the input
+// fraction of the limit.
+func (l *LicenseLimitStatus) IsNearLimit(threshold float64) bool {
+	if l.IsUnlimited {
+		return false
+	}
+	if l.EffectiveLimit <= 0 {
+		return l.CurrentUsage > 0
+	}
+	return l.GetUsage() >= threshold
+}
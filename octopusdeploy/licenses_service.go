@@ -16,3 +16,32 @@ func newLicenseService(sling *sling.Sling, uriTemplate string, currentLicense st
 		service:              newService(ServiceLicenseService, sling, uriTemplate),
 	}
 }
+
+// GetCurrent returns the license installed on the Octopus server.
+func (s licenseService) GetCurrent() (*License, error) {
+	if isEmpty(s.currentLicense) {
+		return nil, createInvalidPathError(s.getName())
+	}
+
+	resp, err := apiGet(s.getClient(), new(License), s.currentLicense)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*License), nil
+}
+
+// GetCurrentStatus returns the compliance status of the license installed
+// on the Octopus server, including its limits and current usage.
+func (s licenseService) GetCurrentStatus() (*LicenseStatus, error) {
+	if isEmpty(s.currentLicenseStatus) {
+		return nil, createInvalidPathError(s.getName())
+	}
+
+	resp, err := apiGet(s.getClient(), new(LicenseStatus), s.currentLicenseStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*LicenseStatus), nil
+}
@@ -0,0 +1,23 @@
+package octopusdeploy
+
+// LifecycleProgression is the progression of a release through the phases
+// of its lifecycle.
+type LifecycleProgression struct {
+	NextDeployments                []string            `json:"NextDeployments"`
+	NextDeploymentsMinimumRequired int                 `json:"NextDeploymentsMinimumRequired,omitempty"`
+	Phases                         []*PhaseProgression `json:"Phases"`
+}
+
+// PhaseProgression is the progression of a release through a phase of its
+// lifecycle.
+type PhaseProgression struct {
+	AutomaticDeploymentTargets         []string        `json:"AutomaticDeploymentTargets"`
+	Blocked                            bool            `json:"Blocked,omitempty"`
+	Deployments                        []DashboardItem `json:"Deployments"`
+	ID                                 string          `json:"Id,omitempty"`
+	IsOptionalPhase                    bool            `json:"IsOptionalPhase,omitempty"`
+	MinimumEnvironmentsBeforePromotion int32           `json:"MinimumEnvironmentsBeforePromotion,omitempty"`
+	Name                               string          `json:"Name,omitempty"`
+	OptionalDeploymentTargets          []string        `json:"OptionalDeploymentTargets"`
+	Progress                           PhaseProgress   `json:"Progress,omitempty"`
+}
@@ -0,0 +1,224 @@
+package octopusdeploy
+
+import (
+	"sort"
+	"time"
+)
+
+// LifecycleSimulator evaluates the rules of a lifecycle locally, without an
+// Octopus server, so that changes to a lifecycle can be checked against
+// known deployment histories.
+type LifecycleSimulator struct {
+	environments map[string]*Environment
+	lifecycle    *Lifecycle
+}
+
+// LifecycleSimulation is the state of a release in a lifecycle: the
+// environments it has been deployed to successfully, the environments it can
+// be deployed to next and, of those, the ones it is deployed to
+// automatically.
+type LifecycleSimulation struct {
+	AutomaticEnvironmentIDs []string                  `json:"AutomaticEnvironmentIds"`
+	DeployedEnvironmentIDs  []string                  `json:"DeployedEnvironmentIds"`
+	NextEnvironmentIDs      []string                  `json:"NextEnvironmentIds"`
+	Phases                  []*LifecyclePhaseProgress `json:"Phases"`
+}
+
+// LifecyclePhaseProgress is how far a release has progressed through a phase
+// of the lifecycle.
+type LifecyclePhaseProgress struct {
+	CompletedEnvironments int      `json:"CompletedEnvironments"`
+	EnvironmentIDs        []string `json:"EnvironmentIds"`
+	IsComplete            bool     `json:"IsComplete"`
+	IsOptional            bool     `json:"IsOptional"`
+	Name                  string   `json:"Name"`
+	RequiredEnvironments  int      `json:"RequiredEnvironments"`
+}
+
+// NewLifecycleSimulator returns a simulator for the lifecycle. The
+// environments are used to order environments by their sort order and, for
+// a lifecycle without phases, as the environments that may be deployed to.
+func NewLifecycleSimulator(lifecycle *Lifecycle, environments []*Environment) *LifecycleSimulator {
+	simulator := &LifecycleSimulator{
+		environments: map[string]*Environment{},
+		lifecycle:    lifecycle,
+	}
+
+	for _, environment := range environments {
+		if environment != nil {
+			simulator.environments[environment.GetID()] = environment
+		}
+	}
+
+	return simulator
+}
+
+// sortEnvironmentIDs sorts the IDs by the sort order of their environments.
+// IDs of unknown environments are placed last, in their original order.
+func (s *LifecycleSimulator) sortEnvironmentIDs(environmentIDs []string) []string {
+	sorted := append([]string{}, environmentIDs...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, aOK := s.environments[sorted[i]]
+		b, bOK := s.environments[sorted[j]]
+		if aOK != bOK {
+			return aOK
+		}
+		return aOK && a.SortOrder < b.SortOrder
+	})
+	return sorted
+}
+
+// Simulate returns the state of a release in the lifecycle, given the
+// deployments of the release. Only successful deployments are taken into
+// account.
+func (s *LifecycleSimulator) Simulate(deployments []DashboardItem) *LifecycleSimulation {
+	simulation := &LifecycleSimulation{
+		AutomaticEnvironmentIDs: []string{},
+		DeployedEnvironmentIDs:  []string{},
+		NextEnvironmentIDs:      []string{},
+		Phases:                  []*LifecyclePhaseProgress{},
+	}
+
+	for _, deployment := range deployments {
+		if deployment.State == string(TaskStateSuccess) && !ValidateStringInSlice(deployment.EnvironmentID, simulation.DeployedEnvironmentIDs) {
+			simulation.DeployedEnvironmentIDs = append(simulation.DeployedEnvironmentIDs, deployment.EnvironmentID)
+		}
+	}
+	simulation.DeployedEnvironmentIDs = s.sortEnvironmentIDs(simulation.DeployedEnvironmentIDs)
+
+	if len(s.lifecycle.Phases) == 0 {
+		environmentIDs := []string{}
+		for environmentID := range s.environments {
+			environmentIDs = append(environmentIDs, environmentID)
+		}
+		sort.Strings(environmentIDs)
+
+		for _, environmentID := range s.sortEnvironmentIDs(environmentIDs) {
+			if !ValidateStringInSlice(environmentID, simulation.DeployedEnvironmentIDs) {
+				simulation.NextEnvironmentIDs = append(simulation.NextEnvironmentIDs, environmentID)
+			}
+		}
+
+		return simulation
+	}
+
+	for _, phase := range s.lifecycle.Phases {
+		completed, required := phase.getCompletion(simulation.DeployedEnvironmentIDs)
+		environmentIDs := s.sortEnvironmentIDs(phase.getEnvironmentIDs())
+
+		simulation.Phases = append(simulation.Phases, &LifecyclePhaseProgress{
+			CompletedEnvironments: completed,
+			EnvironmentIDs:        environmentIDs,
+			IsComplete:            completed >= required,
+			IsOptional:            phase.IsOptionalPhase,
+			Name:                  phase.Name,
+			RequiredEnvironments:  required,
+		})
+
+		for _, environmentID := range environmentIDs {
+			if ValidateStringInSlice(environmentID, simulation.DeployedEnvironmentIDs) || ValidateStringInSlice(environmentID, simulation.NextEnvironmentIDs) {
+				continue
+			}
+
+			if s.lifecycle.CheckPromotion(simulation.DeployedEnvironmentIDs, environmentID) != nil {
+				continue
+			}
+
+			simulation.NextEnvironmentIDs = append(simulation.NextEnvironmentIDs, environmentID)
+			if ValidateStringInSlice(environmentID, phase.AutomaticDeploymentTargets) {
+				simulation.AutomaticEnvironmentIDs = append(simulation.AutomaticEnvironmentIDs, environmentID)
+			}
+		}
+	}
+
+	return simulation
+}
+
+// getReleasePhaseIndexes returns the index of the last phase that each
+// release has been deployed to an environment of, keyed by release ID.
+// Releases that have not reached any phase are left out.
+func (s *LifecycleSimulator) getReleasePhaseIndexes(deployments []DashboardItem) map[string]int {
+	phaseIndexes := map[string]int{}
+	for _, deployment := range deployments {
+		for i, phase := range s.lifecycle.Phases {
+			if !ValidateStringInSlice(deployment.EnvironmentID, phase.getEnvironmentIDs()) {
+				continue
+			}
+
+			if index, ok := phaseIndexes[deployment.ReleaseID]; !ok || i > index {
+				phaseIndexes[deployment.ReleaseID] = i
+			}
+		}
+	}
+	return phaseIndexes
+}
+
+// GetReleasesToDelete returns the releases that the release retention
+// policies of the lifecycle would delete at the input time. A release is
+// retained by the policy of the last phase it has been deployed to, if that
+// phase overrides the release retention policy, and by the policy of the
+// lifecycle otherwise. With a policy in days, releases assembled more than
+// that many days ago are deleted; with a policy in items, all but that many
+// of the most recently assembled releases in the same phase are deleted.
+// Nothing is deleted if the policy keeps releases forever or its quantity is
+// zero. Releases that are current in any environment according to the
+// deployments are never deleted. The releases are returned newest first.
+func (s *LifecycleSimulator) GetReleasesToDelete(releases []*Release, deployments []DashboardItem, asOf time.Time) []*Release {
+	toDelete := []*Release{}
+
+	currentReleaseIDs := []string{}
+	for _, deployment := range deployments {
+		if deployment.IsCurrent {
+			currentReleaseIDs = append(currentReleaseIDs, deployment.ReleaseID)
+		}
+	}
+
+	sorted := []*Release{}
+	for _, release := range releases {
+		if release != nil {
+			sorted = append(sorted, release)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Assembled.After(sorted[j].Assembled)
+	})
+
+	phaseIndexes := s.getReleasePhaseIndexes(deployments)
+	positions := map[int]int{}
+
+	for _, release := range sorted {
+		phaseIndex, ok := phaseIndexes[release.GetID()]
+		if !ok {
+			phaseIndex = -1
+		}
+
+		policy := s.lifecycle.ReleaseRetentionPolicy
+		if phaseIndex >= 0 && s.lifecycle.Phases[phaseIndex].ReleaseRetentionPolicy != nil {
+			policy = *s.lifecycle.Phases[phaseIndex].ReleaseRetentionPolicy
+		}
+
+		position := positions[phaseIndex]
+		positions[phaseIndex]++
+
+		if ValidateStringInSlice(release.GetID(), currentReleaseIDs) {
+			continue
+		}
+
+		if policy.ShouldKeepForever || policy.QuantityToKeep <= 0 {
+			continue
+		}
+
+		switch policy.Unit {
+		case RetentionUnitDays:
+			if release.Assembled.Before(asOf.AddDate(0, 0, -int(policy.QuantityToKeep))) {
+				toDelete = append(toDelete, release)
+			}
+		case RetentionUnitItems:
+			if position >= int(policy.QuantityToKeep) {
+				toDelete = append(toDelete, release)
+			}
+		}
+	}
+
+	return toDelete
+}
@@ -0,0 +1,140 @@
+package octopusdeploy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEnvironments() []*Environment {
+	environments := []*Environment{}
+	for i, name := range []string{"Development", "Production", "Test", "UAT", "Hotfix"} {
+		environment := NewEnvironment(name)
+		environment.ID = "Environments-" + string(rune('1'+i))
+		environment.SortOrder = []int{0, 4, 1, 2, 3}[i]
+		environments = append(environments, environment)
+	}
+	return environments
+}
+
+func TestLifecycleSimulatorSimulate(t *testing.T) {
+	lifecycle := newTestLifecycle()
+	lifecycle.Phases[1].AutomaticDeploymentTargets = []string{"Environments-4"}
+	lifecycle.Phases[1].OptionalDeploymentTargets = []string{"Environments-3"}
+	simulator := NewLifecycleSimulator(lifecycle, newTestEnvironments())
+
+	simulation := simulator.Simulate(nil)
+	require.Empty(t, simulation.DeployedEnvironmentIDs)
+	require.Equal(t, []string{"Environments-1"}, simulation.NextEnvironmentIDs)
+	require.Equal(t, []string{"Environments-1"}, simulation.AutomaticEnvironmentIDs)
+
+	simulation = simulator.Simulate([]DashboardItem{
+		{EnvironmentID: "Environments-1", State: string(TaskStateSuccess)},
+	})
+	require.Equal(t, []string{"Environments-3", "Environments-4"}, simulation.NextEnvironmentIDs)
+	require.Equal(t, []string{"Environments-4"}, simulation.AutomaticEnvironmentIDs)
+	require.True(t, simulation.Phases[0].IsComplete)
+	require.Equal(t, &LifecyclePhaseProgress{
+		CompletedEnvironments: 0,
+		EnvironmentIDs:        []string{"Environments-3", "Environments-4"},
+		Name:                  "Test",
+		RequiredEnvironments:  1,
+	}, simulation.Phases[1])
+
+	// one test environment completes the phase, and the hotfix phase is
+	// optional
+	simulation = simulator.Simulate([]DashboardItem{
+		{EnvironmentID: "Environments-1", State: string(TaskStateSuccess)},
+		{EnvironmentID: "Environments-3", State: string(TaskStateFailed)},
+		{EnvironmentID: "Environments-4", State: string(TaskStateSuccess)},
+	})
+	require.Equal(t, []string{"Environments-1", "Environments-4"}, simulation.DeployedEnvironmentIDs)
+	require.Equal(t, []string{"Environments-3", "Environments-5", "Environments-2"}, simulation.NextEnvironmentIDs)
+	require.Empty(t, simulation.AutomaticEnvironmentIDs)
+	require.True(t, simulation.Phases[1].IsComplete)
+	require.False(t, simulation.Phases[2].IsComplete)
+	require.True(t, simulation.Phases[2].IsOptional)
+}
+
+func TestLifecycleSimulatorSimulateWithoutPhases(t *testing.T) {
+	simulator := NewLifecycleSimulator(NewLifecycle("Default"), newTestEnvironments())
+
+	simulation := simulator.Simulate([]DashboardItem{
+		{EnvironmentID: "Environments-3", State: string(TaskStateSuccess)},
+	})
+	require.Equal(t, []string{"Environments-1", "Environments-4", "Environments-5", "Environments-2"}, simulation.NextEnvironmentIDs)
+	require.Empty(t, simulation.AutomaticEnvironmentIDs)
+	require.Empty(t, simulation.Phases)
+}
+
+func TestLifecycleSimulatorGetReleasesToDelete(t *testing.T) {
+	asOf := time.Date(2020, 10, 31, 0, 0, 0, 0, time.UTC)
+
+	releases := []*Release{}
+	for i := 1; i <= 5; i++ {
+		release := NewRelease("Channels-1", "Projects-1", "1.0."+string(rune('0'+i)))
+		release.ID = "Releases-" + string(rune('0'+i))
+		release.Assembled = asOf.AddDate(0, 0, -10*(5-i))
+		releases = append(releases, release)
+	}
+
+	deployments := []DashboardItem{
+		{EnvironmentID: "Environments-2", IsCurrent: true, ReleaseID: "Releases-1"},
+		{EnvironmentID: "Environments-1", IsPrevious: true, ReleaseID: "Releases-2"},
+	}
+
+	lifecycle := NewLifecycle("Default")
+	simulator := NewLifecycleSimulator(lifecycle, nil)
+
+	// releases assembled exactly 30 days ago are kept
+	require.Empty(t, simulator.GetReleasesToDelete(releases, deployments, asOf))
+
+	lifecycle.ReleaseRetentionPolicy = RetentionPeriod{QuantityToKeep: 25, Unit: RetentionUnitDays}
+	toDelete := simulator.GetReleasesToDelete(releases, deployments, asOf)
+	require.Equal(t, []*Release{releases[1]}, toDelete)
+
+	lifecycle.ReleaseRetentionPolicy = RetentionPeriod{QuantityToKeep: 2, Unit: RetentionUnitItems}
+	toDelete = simulator.GetReleasesToDelete(releases, deployments, asOf)
+	require.Equal(t, []*Release{releases[2], releases[1]}, toDelete)
+
+	lifecycle.ReleaseRetentionPolicy = RetentionPeriod{QuantityToKeep: 2, ShouldKeepForever: true, Unit: RetentionUnitItems}
+	require.Empty(t, simulator.GetReleasesToDelete(releases, deployments, asOf))
+
+	lifecycle.ReleaseRetentionPolicy = RetentionPeriod{Unit: RetentionUnitDays}
+	require.Empty(t, simulator.GetReleasesToDelete(releases, deployments, asOf))
+}
+
+func TestLifecycleSimulatorGetReleasesToDeleteWithPhaseRetention(t *testing.T) {
+	asOf := time.Date(2020, 10, 31, 0, 0, 0, 0, time.UTC)
+
+	releases := []*Release{}
+	for i := 1; i <= 5; i++ {
+		release := NewRelease("Channels-1", "Projects-1", "1.0."+string(rune('0'+i)))
+		release.ID = "Releases-" + string(rune('0'+i))
+		release.Assembled = asOf.AddDate(0, 0, -10*(5-i))
+		releases = append(releases, release)
+	}
+
+	deployments := []DashboardItem{
+		{EnvironmentID: "Environments-1", IsPrevious: true, ReleaseID: "Releases-1"},
+		{EnvironmentID: "Environments-2", IsPrevious: true, ReleaseID: "Releases-1"},
+		{EnvironmentID: "Environments-2", IsCurrent: true, ReleaseID: "Releases-2"},
+		{EnvironmentID: "Environments-1", IsCurrent: true, ReleaseID: "Releases-3"},
+	}
+
+	lifecycle := NewLifecycle("Default")
+	lifecycle.ReleaseRetentionPolicy = RetentionPeriod{QuantityToKeep: 1, Unit: RetentionUnitItems}
+	lifecycle.Phases = []Phase{
+		{Name: "Development", OptionalDeploymentTargets: []string{"Environments-1"}},
+		{Name: "Production", OptionalDeploymentTargets: []string{"Environments-2"}, ReleaseRetentionPolicy: &RetentionPeriod{QuantityToKeep: 365, Unit: RetentionUnitDays}},
+	}
+	simulator := NewLifecycleSimulator(lifecycle, nil)
+
+	// the release that reached production is kept by the policy of the phase
+	require.Equal(t, []*Release{releases[3]}, simulator.GetReleasesToDelete(releases, deployments, asOf))
+
+	// without the override the lifecycle policy applies to every phase
+	lifecycle.Phases[1].ReleaseRetentionPolicy = nil
+	require.Equal(t, []*Release{releases[3], releases[0]}, simulator.GetReleasesToDelete(releases, deployments, asOf))
+}
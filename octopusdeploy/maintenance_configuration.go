@@ -0,0 +1,17 @@
+package octopusdeploy
+
+// MaintenanceConfiguration represents the maintenance mode settings of the
+// Octopus server.
+type MaintenanceConfiguration struct {
+	IsInMaintenanceMode bool `json:"IsInMaintenanceMode"`
+
+	resource
+}
+
+// NewMaintenanceConfiguration initializes maintenance mode settings.
+func NewMaintenanceConfiguration(isInMaintenanceMode bool) *MaintenanceConfiguration {
+	return &MaintenanceConfiguration{
+		IsInMaintenanceMode: isInMaintenanceMode,
+		resource:            *newResource(),
+	}
+}
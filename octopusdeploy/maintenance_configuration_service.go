@@ -11,3 +11,38 @@ func newMaintenanceConfigurationService(sling *sling.Sling, uriTemplate string)
 		service: newService(ServiceMaintenanceConfigurationService, sling, uriTemplate),
 	}
 }
+
+// Get returns the maintenance configuration of the Octopus server.
+func (s maintenanceConfigurationService) Get() (*MaintenanceConfiguration, error) {
+	path, err := getPath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiGet(s.getClient(), new(MaintenanceConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*MaintenanceConfiguration), nil
+}
+
+// Update modifies the maintenance configuration of the Octopus server based on
+// the one provided as input.
+func (s maintenanceConfigurationService) Update(maintenanceConfiguration *MaintenanceConfiguration) (*MaintenanceConfiguration, error) {
+	if maintenanceConfiguration == nil {
+		return nil, createInvalidParameterError(OperationUpdate, ParameterMaintenanceConfiguration)
+	}
+
+	path, err := getUpdatePath(s, maintenanceConfiguration)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiUpdate(s.getClient(), maintenanceConfiguration, new(MaintenanceConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*MaintenanceConfiguration), nil
+}
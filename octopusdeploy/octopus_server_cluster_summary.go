@@ -0,0 +1,37 @@
+package octopusdeploy
+
+import "time"
+
+// OctopusServerClusterSummary represents the state of every node in an Octopus
+// server cluster.
+type OctopusServerClusterSummary struct {
+	Nodes []*OctopusServerNodeSummary `json:"Nodes"`
+
+	resource
+}
+
+// OctopusServerNodeSummary represents the state of a single node within an
+// Octopus server cluster.
+type OctopusServerNodeSummary struct {
+	IsInMaintenanceMode bool       `json:"IsInMaintenanceMode"`
+	IsOffline           bool       `json:"IsOffline"`
+	LastSeen            *time.Time `json:"LastSeen,omitempty"`
+	MaxConcurrentTasks  int32      `json:"MaxConcurrentTasks,omitempty"`
+	Name                string     `json:"Name,omitempty"`
+	Rank                string     `json:"Rank,omitempty"`
+	RunningTaskCount    int        `json:"RunningTaskCount"`
+
+	resource
+}
+
+// GetNode returns the summary of the node that matches the input name, or nil
+// if the cluster does not contain it.
+func (c *OctopusServerClusterSummary) GetNode(name string) *OctopusServerNodeSummary {
+	for _, node := range c.Nodes {
+		if node.Name == name {
+			return node
+		}
+	}
+
+	return nil
+}
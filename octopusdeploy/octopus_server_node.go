@@ -1,9 +1,18 @@
 package octopusdeploy
 
+import "github.com/go-playground/validator/v10"
+
+// OctopusServerNodes defines a collection of Octopus server nodes with
+// built-in support for paged results.
+type OctopusServerNodes struct {
+	Items []*OctopusServerNodeResource `json:"Items"`
+	PagedResults
+}
+
 type OctopusServerNodeResource struct {
-	IsInMaintenanceMode bool   `json:"IsInMaintenanceMode,omitempty"`
+	IsInMaintenanceMode bool   `json:"IsInMaintenanceMode"`
 	MaxConcurrentTasks  int32  `json:"MaxConcurrentTasks,omitempty"`
-	Name                string `json:"Name,omitempty"`
+	Name                string `json:"Name,omitempty" validate:"required"`
 
 	resource
 }
@@ -13,3 +22,9 @@ func NewOctopusServerNodeResource() *OctopusServerNodeResource {
 		resource: *newResource(),
 	}
 }
+
+// Validate checks the state of the Octopus server node and returns an error if
+// invalid.
+func (o *OctopusServerNodeResource) Validate() error {
+	return validator.New().Struct(o)
+}
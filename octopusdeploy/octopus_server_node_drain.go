@@ -0,0 +1,70 @@
+package octopusdeploy
+
+import "time"
+
+// drainNodePollInterval is how often DrainNode checks the running tasks of a
+// node while waiting for them to finish.
+var drainNodePollInterval = 5 * time.Second
+
+// DrainNodeResult reports the outcome of draining an Octopus server node.
+type DrainNodeResult struct {
+	Drained      bool                       `json:"Drained"`
+	Elapsed      time.Duration              `json:"Elapsed"`
+	Node         *OctopusServerNodeResource `json:"Node"`
+	RunningTasks []*Task                    `json:"RunningTasks"`
+}
+
+// DrainNode switches on drain (maintenance mode) for the Octopus server node
+// that matches the input name and waits until it has no running tasks. If
+// tasks are still running when the timeout elapses, the result is returned
+// along with an error; the node is left in maintenance mode either way.
+func (c *Client) DrainNode(name string, timeout time.Duration) (*DrainNodeResult, error) {
+	if isEmpty(name) {
+		return nil, createInvalidParameterError(OperationDrainNode, ParameterName)
+	}
+
+	node, err := c.OctopusServerNodes.GetByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !node.IsInMaintenanceMode {
+		node.IsInMaintenanceMode = true
+		node, err = c.OctopusServerNodes.Update(node)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := &DrainNodeResult{Node: node}
+	start := time.Now()
+
+	for {
+		tasks, err := c.Tasks.GetAll(TasksQuery{
+			IsRunning: true,
+			Node:      node.Name,
+		})
+		if err != nil {
+			return result, err
+		}
+
+		result.Elapsed = time.Since(start)
+		result.RunningTasks = tasks
+
+		if len(tasks) == 0 {
+			result.Drained = true
+			return result, nil
+		}
+
+		remaining := timeout - result.Elapsed
+		if remaining <= 0 {
+			return result, createTimeoutError(OperationDrainNode, timeout)
+		}
+
+		if remaining > drainNodePollInterval {
+			remaining = drainNodePollInterval
+		}
+
+		time.Sleep(remaining)
+	}
+}
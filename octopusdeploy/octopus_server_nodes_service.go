@@ -1,9 +1,13 @@
 package octopusdeploy
 
-import "github.com/dghubble/sling"
+import (
+	"github.com/dghubble/sling"
+	"github.com/google/go-querystring/query"
+)
 
 type octopusServerNodeService struct {
-	clusterSummaryPath string
+	clusterSummaryPath             string
+	clusterSummaryUnsupportedError error
 
 	canDeleteService
 }
@@ -16,3 +20,127 @@ func newOctopusServerNodeService(sling *sling.Sling, uriTemplate string, cluster
 
 	return octopusServerNodeService
 }
+
+func (s octopusServerNodeService) getPagedResponse(path string) ([]*OctopusServerNodeResource, error) {
+	resources := []*OctopusServerNodeResource{}
+	loadNextPage := true
+
+	for loadNextPage {
+		resp, err := apiGet(s.getClient(), new(OctopusServerNodes), path)
+		if err != nil {
+			return resources, err
+		}
+
+		responseList := resp.(*OctopusServerNodes)
+		resources = append(resources, responseList.Items...)
+		path, loadNextPage = LoadNextPage(responseList.PagedResults)
+	}
+
+	return resources, nil
+}
+
+// Get returns a collection of Octopus server nodes based on the criteria
+// defined by its input query parameter. If an error occurs, an empty
+// collection is returned along with the associated error.
+func (s octopusServerNodeService) Get(octopusServerNodesQuery OctopusServerNodesQuery) (*OctopusServerNodes, error) {
+	v, _ := query.Values(octopusServerNodesQuery)
+	path := s.BasePath
+	encodedQueryString := v.Encode()
+	if len(encodedQueryString) > 0 {
+		path += "?" + encodedQueryString
+	}
+
+	resp, err := apiGet(s.getClient(), new(OctopusServerNodes), path)
+	if err != nil {
+		return &OctopusServerNodes{}, err
+	}
+
+	return resp.(*OctopusServerNodes), nil
+}
+
+// GetAll returns all Octopus server nodes. If none can be found or an error
+// occurs, it returns an empty collection.
+func (s octopusServerNodeService) GetAll() ([]*OctopusServerNodeResource, error) {
+	path, err := getPath(s)
+	if err != nil {
+		return []*OctopusServerNodeResource{}, err
+	}
+
+	return s.getPagedResponse(path)
+}
+
+// GetByID returns the Octopus server node that matches the input ID. If one
+// cannot be found, it returns nil and an error.
+func (s octopusServerNodeService) GetByID(id string) (*OctopusServerNodeResource, error) {
+	path, err := getByIDPath(s, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiGet(s.getClient(), new(OctopusServerNodeResource), path)
+	if err != nil {
+		return nil, createResourceNotFoundError(s.getName(), "ID", id)
+	}
+
+	return resp.(*OctopusServerNodeResource), nil
+}
+
+// GetByName returns the Octopus server node with a matching name. If one
+// cannot be found, it returns nil and an error.
+func (s octopusServerNodeService) GetByName(name string) (*OctopusServerNodeResource, error) {
+	if isEmpty(name) {
+		return nil, createInvalidParameterError(OperationGetByName, ParameterName)
+	}
+
+	path, err := getByPartialNamePath(s, name)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := s.getPagedResponse(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, node := range nodes {
+		if node.Name == name {
+			return node, nil
+		}
+	}
+
+	return nil, createItemNotFoundError(s.getName(), OperationGetByName, name)
+}
+
+// GetClusterSummary returns the state of every node in the cluster, including
+// the number of tasks each node is running and when it was last seen.
+func (s octopusServerNodeService) GetClusterSummary() (*OctopusServerClusterSummary, error) {
+	if isEmpty(s.clusterSummaryPath) {
+		return nil, s.getPathError(s.clusterSummaryUnsupportedError)
+	}
+
+	resp, err := apiGet(s.getClient(), new(OctopusServerClusterSummary), s.clusterSummaryPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*OctopusServerClusterSummary), nil
+}
+
+// Update modifies an Octopus server node based on the one provided as input.
+func (s octopusServerNodeService) Update(resource *OctopusServerNodeResource) (*OctopusServerNodeResource, error) {
+	if resource == nil {
+		return nil, createInvalidParameterError(OperationUpdate, ParameterOctopusServerNode)
+	}
+
+	path, err := getUpdatePath(s, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiUpdate(s.getClient(), resource, new(OctopusServerNodeResource), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*OctopusServerNodeResource), nil
+}
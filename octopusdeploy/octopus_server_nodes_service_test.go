@@ -0,0 +1,130 @@
+package octopusdeploy
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/stretchr/testify/require"
+)
+
+func createOctopusServerNodeService(t *testing.T) *octopusServerNodeService {
+	service := newOctopusServerNodeService(nil, TestURIOctopusServerNodes, TestURIOctopusServerClusterSummary)
+	testNewService(t, service, TestURIOctopusServerNodes, ServiceOctopusServerNodeService)
+	return service
+}
+
+func TestOctopusServerNodeServiceNew(t *testing.T) {
+	ServiceFunction := newOctopusServerNodeService
+	client := &sling.Sling{}
+	uriTemplate := emptyString
+	clusterSummaryPath := emptyString
+	ServiceName := ServiceOctopusServerNodeService
+
+	testCases := []struct {
+		name               string
+		f                  func(*sling.Sling, string, string) *octopusServerNodeService
+		client             *sling.Sling
+		uriTemplate        string
+		clusterSummaryPath string
+	}{
+		{"NilClient", ServiceFunction, nil, uriTemplate, clusterSummaryPath},
+		{"EmptyURITemplate", ServiceFunction, client, emptyString, clusterSummaryPath},
+		{"URITemplateWithWhitespace", ServiceFunction, client, whitespaceString, clusterSummaryPath},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := tc.f(tc.client, tc.uriTemplate, tc.clusterSummaryPath)
+			testNewService(t, service, uriTemplate, ServiceName)
+		})
+	}
+}
+
+func TestOctopusServerNodeServiceParameters(t *testing.T) {
+	service := createOctopusServerNodeService(t)
+	require.NotNil(t, service)
+
+	resource, err := service.GetByID(emptyString)
+	require.Equal(t, createInvalidParameterError(OperationGetByID, ParameterID), err)
+	require.Nil(t, resource)
+
+	resource, err = service.GetByName(whitespaceString)
+	require.Equal(t, createInvalidParameterError(OperationGetByName, ParameterName), err)
+	require.Nil(t, resource)
+
+	resource, err = service.Update(nil)
+	require.Equal(t, createInvalidParameterError(OperationUpdate, ParameterOctopusServerNode), err)
+	require.Nil(t, resource)
+
+	err = service.DeleteByID(emptyString)
+	require.Equal(t, createInvalidParameterError(OperationDeleteByID, ParameterID), err)
+}
+
+func TestOctopusServerNodeServiceGetClusterSummary(t *testing.T) {
+	client := createFakeSling(func(r *http.Request) (int, string) {
+		require.Equal(t, TestURIOctopusServerClusterSummary, r.URL.Path)
+		return http.StatusOK, `{"Nodes":[{"Id":"OctopusServerNodes-a","Name":"a","RunningTaskCount":3,"LastSeen":"2020-10-01T10:00:00Z"}]}`
+	})
+	service := newOctopusServerNodeService(client, TestURIOctopusServerNodes, TestURIOctopusServerClusterSummary)
+
+	summary, err := service.GetClusterSummary()
+	require.NoError(t, err)
+	require.Len(t, summary.Nodes, 1)
+
+	node := summary.GetNode("a")
+	require.NotNil(t, node)
+	require.Equal(t, 3, node.RunningTaskCount)
+	require.Equal(t, time.Date(2020, 10, 1, 10, 0, 0, 0, time.UTC), node.LastSeen.UTC())
+	require.Nil(t, summary.GetNode("b"))
+}
+
+func TestDrainNode(t *testing.T) {
+	drainNodePollInterval = time.Millisecond
+	defer func() { drainNodePollInterval = 5 * time.Second }()
+
+	taskQueries := 0
+	updated := false
+	client := createFakeSling(func(r *http.Request) (int, string) {
+		switch {
+		case r.Method == http.MethodPut:
+			updated = true
+			return http.StatusOK, `{"Id":"OctopusServerNodes-a","Name":"a","IsInMaintenanceMode":true}`
+		case r.URL.Path == "/api/octopusservernodes":
+			require.Equal(t, "a", r.URL.Query().Get("partialName"))
+			return http.StatusOK, `{"Items":[{"Id":"OctopusServerNodes-ab","Name":"ab"},{"Id":"OctopusServerNodes-a","Name":"a"}]}`
+		case r.URL.Path == "/api/tasks":
+			require.Equal(t, "a", r.URL.Query().Get("node"))
+			require.Equal(t, "true", r.URL.Query().Get("running"))
+			taskQueries++
+			if taskQueries < 3 {
+				return http.StatusOK, `{"Items":[{"Id":"ServerTasks-1","State":"Executing"}]}`
+			}
+			return http.StatusOK, `{"Items":[]}`
+		}
+		return http.StatusNotFound, `{}`
+	})
+
+	octopusClient := &Client{
+		OctopusServerNodes: newOctopusServerNodeService(client, TestURIOctopusServerNodes, TestURIOctopusServerClusterSummary),
+		Tasks:              newTaskService(client, TestURITasks, TestURITaskTypes),
+	}
+
+	result, err := octopusClient.DrainNode(emptyString, time.Second)
+	require.Equal(t, createInvalidParameterError(OperationDrainNode, ParameterName), err)
+	require.Nil(t, result)
+
+	result, err = octopusClient.DrainNode("a", time.Second)
+	require.NoError(t, err)
+	require.True(t, updated)
+	require.True(t, result.Drained)
+	require.True(t, result.Node.IsInMaintenanceMode)
+	require.Empty(t, result.RunningTasks)
+	require.Equal(t, 3, taskQueries)
+
+	taskQueries = -100
+	result, err = octopusClient.DrainNode("a", 0)
+	require.Equal(t, createTimeoutError(OperationDrainNode, 0), err)
+	require.False(t, result.Drained)
+	require.Len(t, result.RunningTasks, 1)
+}
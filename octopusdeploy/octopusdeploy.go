@@ -12,6 +12,9 @@ import (
 
 // Client is an OctopusDeploy for making Octopus API requests.
 type Client struct {
+	apiVersion                     string
+	links                          map[string]string
+	serverVersion                  *ServerVersion
 	sling                          *sling.Sling
 	Accounts                       *accountService
 	ActionTemplates                *actionTemplateService
@@ -123,6 +126,12 @@ func NewClient(httpClient *http.Client, apiURL *url.URL, apiKey string, spaceID
 		return nil, err
 	}
 
+	serverRoot := root
+	links := map[string]string{}
+	for linkRelation, link := range root.Links {
+		links[linkRelation] = link
+	}
+
 	rootPath := root.Links[linkSelf]
 	apiKeysPath := "/api/users"
 	dynamicExtensionsPath := "/api/dynamic-extensions"
@@ -281,6 +290,12 @@ func NewClient(httpClient *http.Client, apiURL *url.URL, apiKey string, spaceID
 			return nil, err
 		}
 
+		for linkRelation, link := range root.Links {
+			if !isEmpty(link) {
+				links[linkRelation] = link
+			}
+		}
+
 		if !isEmpty(root.Links[linkAccounts]) {
 			accountsPath = root.Links[linkAccounts]
 		}
@@ -561,6 +576,13 @@ func NewClient(httpClient *http.Client, apiURL *url.URL, apiKey string, spaceID
 			projectTriggersPath = root.Links[linkProjectTriggers]
 		}
 
+		if !isEmpty(root.Links[linkReportingDeploymentsCountedByWeek]) {
+			reportingDeploymentsCountedByWeekPath = root.Links[linkReportingDeploymentsCountedByWeek]
+			if parentPath := getParentPath(reportingDeploymentsCountedByWeekPath); !isEmpty(parentPath) {
+				reportingPath = parentPath
+			}
+		}
+
 		if !isEmpty(root.Links[linkSelf]) {
 			rootPath = root.Links[linkSelf]
 		}
@@ -577,6 +599,18 @@ func NewClient(httpClient *http.Client, apiURL *url.URL, apiKey string, spaceID
 			tenantsPath = root.Links[linkTenants]
 		}
 
+		if !isEmpty(root.Links[linkTenantsMissingVariables]) {
+			tenantsMissingVariablesPath = root.Links[linkTenantsMissingVariables]
+		}
+
+		if !isEmpty(root.Links[linkTenantsStatus]) {
+			tenantsStatusPath = root.Links[linkTenantsStatus]
+		}
+
+		if !isEmpty(root.Links[linkTenantVariables]) {
+			tenantVariablesPath = root.Links[linkTenantVariables]
+		}
+
 		if !isEmpty(root.Links[linkUsers]) {
 			usersPath = root.Links[linkUsers]
 		}
@@ -594,7 +628,7 @@ func NewClient(httpClient *http.Client, apiURL *url.URL, apiKey string, spaceID
 		}
 	}
 
-	return &Client{
+	client := &Client{
 		sling:                          base,
 		Accounts:                       newAccountService(base, accountsPath),
 		ActionTemplates:                newActionTemplateService(base, actionTemplatesPath, actionTemplatesCategories, actionTemplatesLogo, actionTemplatesSearch, actionTemplateVersionedLogo),
@@ -662,7 +696,7 @@ func NewClient(httpClient *http.Client, apiURL *url.URL, apiKey string, spaceID
 		TeamMembership:                 newTeamMembershipService(base, teamMembershipPath, teamMembershipPreviewTeamPath),
 		Teams:                          newTeamService(base, teamsPath),
 		Tenants:                        newTenantService(base, tenantsPath, tenantsMissingVariablesPath, tenantsStatusPath, tenantTagTestPath),
-		TenantVariables:                newTenantVariableService(base, tenantVariablesPath),
+		TenantVariables:                newTenantVariableService(base, tenantVariablesPath, tenantsPath),
 		UpgradeConfiguration:           newUpgradeConfigurationService(base, upgradeConfigurationPath),
 		UserOnboarding:                 newUserOnboardingService(base, userOnboardingPath),
 		UserRoles:                      newUserRoleService(base, userRolesPath),
@@ -671,7 +705,11 @@ func NewClient(httpClient *http.Client, apiURL *url.URL, apiKey string, spaceID
 		WorkerPools:                    newWorkerPoolService(base, workerPoolsPath, workerPoolsDynamicWorkerTypesPath, workerPoolsSortOrderPath, workerPoolsSummaryPath, workerPoolsSupportedTypesPath),
 		Workers:                        newWorkerService(base, workersPath, discoverWorkerPath, workerOperatingSystemsPath, workerShellsPath),
 		WorkerToolsLatestImages:        newWorkerToolsLatestImageService(base, workerToolsLatestImagesPath),
-	}, nil
+	}
+
+	client.setServerCapabilities(serverRoot, links)
+
+	return client, nil
 }
 
 // APIError is a generic structure for containing errors for API operations.
@@ -0,0 +1,8 @@
+package octopusdeploy
+
+// PackageVersions defines a collection of package versions with built-in
+// support for paged results.
+type PackageVersions struct {
+	Items []*PackageVersion `json:"Items"`
+	PagedResults
+}
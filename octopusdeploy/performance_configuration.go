@@ -0,0 +1,26 @@
+package octopusdeploy
+
+import "github.com/go-playground/validator/v10"
+
+// PerformanceConfiguration represents the performance settings of the Octopus
+// server.
+type PerformanceConfiguration struct {
+	DefaultDashboardRenderMode DashboardRenderMode `json:"DefaultDashboardRenderMode,omitempty" validate:"omitempty,oneof=ConservativeRender ProgressiveRender"`
+
+	resource
+}
+
+// NewPerformanceConfiguration initializes performance settings with a
+// dashboard render mode.
+func NewPerformanceConfiguration(defaultDashboardRenderMode DashboardRenderMode) *PerformanceConfiguration {
+	return &PerformanceConfiguration{
+		DefaultDashboardRenderMode: defaultDashboardRenderMode,
+		resource:                   *newResource(),
+	}
+}
+
+// Validate checks the state of the performance configuration and returns an
+// error if invalid.
+func (p *PerformanceConfiguration) Validate() error {
+	return validator.New().Struct(p)
+}
@@ -11,3 +11,38 @@ func newPerformanceConfigurationService(sling *sling.Sling, uriTemplate string)
 		service: newService(ServicePerformanceConfigurationService, sling, uriTemplate),
 	}
 }
+
+// Get returns the performance configuration of the Octopus server.
+func (s performanceConfigurationService) Get() (*PerformanceConfiguration, error) {
+	path, err := getPath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiGet(s.getClient(), new(PerformanceConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*PerformanceConfiguration), nil
+}
+
+// Update modifies the performance configuration of the Octopus server based on
+// the one provided as input.
+func (s performanceConfigurationService) Update(performanceConfiguration *PerformanceConfiguration) (*PerformanceConfiguration, error) {
+	if performanceConfiguration == nil {
+		return nil, createInvalidParameterError(OperationUpdate, ParameterPerformanceConfiguration)
+	}
+
+	path, err := getUpdatePath(s, performanceConfiguration)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiUpdate(s.getClient(), performanceConfiguration, new(PerformanceConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*PerformanceConfiguration), nil
+}
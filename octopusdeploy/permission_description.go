@@ -6,3 +6,9 @@ type PermissionDescription struct {
 	Description           string   `json:"Description,omitempty"`
 	SupportedRestrictions []string `json:"SupportedRestrictions"`
 }
+
+// SupportsRestriction returns true if the permission can be restricted in the
+// way described by the input restriction.
+func (p *PermissionDescription) SupportsRestriction(restriction PermissionRestriction) bool {
+	return ValidateStringInSlice(string(restriction), p.SupportedRestrictions)
+}
@@ -0,0 +1,106 @@
+package octopusdeploy
+
+// PermissionEvaluator answers whether a user holds a permission in a given
+// scope, based on the user's effective permission set.
+type PermissionEvaluator struct {
+	permissions     *UserPermissionSet
+	projectGroupIDs map[string]string
+}
+
+// NewPermissionEvaluator returns an evaluator for the input permission set.
+// The projects are used to find the project group of a project, so that
+// restrictions to project groups can be evaluated; they may be nil if no
+// restriction is to a project group.
+func NewPermissionEvaluator(permissions *UserPermissionSet, projects []*Project) *PermissionEvaluator {
+	evaluator := &PermissionEvaluator{
+		permissions:     permissions,
+		projectGroupIDs: map[string]string{},
+	}
+
+	for _, project := range projects {
+		if project != nil {
+			evaluator.projectGroupIDs[project.GetID()] = project.ProjectGroupID
+		}
+	}
+
+	return evaluator
+}
+
+// Can returns true if the user holds the permission in the input scope.
+// System permissions are granted regardless of scope. A space permission is
+// granted if any of its restrictions covers the scope: the restriction is
+// for the space, and for each of projects, environments and tenants it
+// either is unrestricted or lists the input ID.
+// A project is covered if it, or its project group, is listed. An empty ID
+// asks about no particular space, project, environment or tenant, and is
+// only covered by restrictions that do not restrict that scope.
+func (e *PermissionEvaluator) Can(permission string, spaceID string, projectID string, environmentID string, tenantID string) bool {
+	if e == nil || e.permissions == nil || isEmpty(permission) {
+		return false
+	}
+
+	if ValidateStringInSlice(permission, e.permissions.SystemPermissions) {
+		return true
+	}
+
+	for _, restriction := range e.permissions.SpacePermissions.GetRestrictions(permission) {
+		if e.covers(restriction, spaceID, projectID, environmentID, tenantID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *PermissionEvaluator) covers(restriction UserPermissionRestriction, spaceID string, projectID string, environmentID string, tenantID string) bool {
+	if restriction.SpaceID != spaceID {
+		return false
+	}
+
+	if len(restriction.RestrictedToProjectIds) > 0 || len(restriction.RestrictedToProjectGroupIds) > 0 {
+		if isEmpty(projectID) {
+			return false
+		}
+
+		projectGroupID := e.projectGroupIDs[projectID]
+		if !ValidateStringInSlice(projectID, restriction.RestrictedToProjectIds) &&
+			(isEmpty(projectGroupID) || !ValidateStringInSlice(projectGroupID, restriction.RestrictedToProjectGroupIds)) {
+			return false
+		}
+	}
+
+	if !isScopeCovered(environmentID, restriction.RestrictedToEnvironmentIds) {
+		return false
+	}
+
+	return isScopeCovered(tenantID, restriction.RestrictedToTenantIds)
+}
+
+// isScopeCovered returns true if the ID is within the restricted IDs, or if
+// there is no restriction.
+func isScopeCovered(id string, restrictedIDs []string) bool {
+	if len(restrictedIDs) == 0 {
+		return true
+	}
+	return !isEmpty(id) && ValidateStringInSlice(id, restrictedIDs)
+}
+
+// GetPermissionEvaluator loads the effective permissions of the input user
+// and the projects needed to evaluate restrictions to project groups.
+func (c *Client) GetPermissionEvaluator(user *User) (*PermissionEvaluator, error) {
+	if user == nil {
+		return nil, createInvalidParameterError(OperationGetPermissionEvaluator, ParameterUser)
+	}
+
+	permissions, err := c.Users.GetPermissions(user)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := c.Projects.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPermissionEvaluator(permissions, projects), nil
+}
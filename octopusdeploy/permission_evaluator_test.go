@@ -0,0 +1,108 @@
+package octopusdeploy
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermissionEvaluatorCan(t *testing.T) {
+	permissions := &UserPermissionSet{
+		SpacePermissions: SpacePermissions{
+			DeploymentCreate: []UserPermissionRestriction{
+				{
+					RestrictedToEnvironmentIds: []string{"Environments-1"},
+					RestrictedToProjectIds:     []string{"Projects-1"},
+					SpaceID:                    "Spaces-1",
+				},
+				{
+					RestrictedToEnvironmentIds:  []string{"Environments-2"},
+					RestrictedToProjectGroupIds: []string{"ProjectGroups-2"},
+					RestrictedToTenantIds:       []string{"Tenants-1"},
+					SpaceID:                     "Spaces-1",
+				},
+			},
+			ProjectView: []UserPermissionRestriction{
+				{SpaceID: "Spaces-1"},
+			},
+			TaskView: []UserPermissionRestriction{
+				{},
+			},
+		},
+		SystemPermissions: []string{"AdministerSystem"},
+	}
+
+	projects := []*Project{
+		{ProjectGroupID: "ProjectGroups-1", resource: resource{ID: "Projects-1"}},
+		{ProjectGroupID: "ProjectGroups-2", resource: resource{ID: "Projects-2"}},
+	}
+
+	evaluator := NewPermissionEvaluator(permissions, projects)
+
+	cases := []struct {
+		name          string
+		permission    string
+		spaceID       string
+		projectID     string
+		environmentID string
+		tenantID      string
+		expected      bool
+	}{
+		{"SystemPermission", "AdministerSystem", emptyString, emptyString, emptyString, emptyString, true},
+		{"UnknownPermission", "Unknown", "Spaces-1", "Projects-1", "Environments-1", emptyString, false},
+		{"NotGranted", "EnvironmentEdit", "Spaces-1", emptyString, emptyString, emptyString, false},
+		{"ProjectAndEnvironment", "DeploymentCreate", "Spaces-1", "Projects-1", "Environments-1", emptyString, true},
+		{"ProjectAndEnvironmentWithTenant", "DeploymentCreate", "Spaces-1", "Projects-1", "Environments-1", "Tenants-9", true},
+		{"OtherEnvironment", "DeploymentCreate", "Spaces-1", "Projects-1", "Environments-2", emptyString, false},
+		{"OtherSpace", "DeploymentCreate", "Spaces-2", "Projects-1", "Environments-1", emptyString, false},
+		{"NoProject", "DeploymentCreate", "Spaces-1", emptyString, "Environments-1", emptyString, false},
+		{"ProjectGroup", "DeploymentCreate", "Spaces-1", "Projects-2", "Environments-2", "Tenants-1", true},
+		{"ProjectGroupWithoutTenant", "DeploymentCreate", "Spaces-1", "Projects-2", "Environments-2", emptyString, false},
+		{"ProjectGroupOtherTenant", "DeploymentCreate", "Spaces-1", "Projects-2", "Environments-2", "Tenants-2", false},
+		{"UnknownProject", "DeploymentCreate", "Spaces-1", "Projects-3", "Environments-2", "Tenants-1", false},
+		{"Unrestricted", "ProjectView", "Spaces-1", "Projects-3", emptyString, emptyString, true},
+		{"UnrestrictedNoSpace", "ProjectView", emptyString, emptyString, emptyString, emptyString, false},
+		{"NoSpaceRestriction", "TaskView", emptyString, emptyString, emptyString, emptyString, true},
+		{"NoSpaceRestrictionOtherSpace", "TaskView", "Spaces-2", emptyString, emptyString, emptyString, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, evaluator.Can(tc.permission, tc.spaceID, tc.projectID, tc.environmentID, tc.tenantID))
+		})
+	}
+
+	require.False(t, NewPermissionEvaluator(nil, nil).Can("AdministerSystem", emptyString, emptyString, emptyString, emptyString))
+}
+
+func TestGetPermissionEvaluator(t *testing.T) {
+	c := &Client{}
+	_, err := c.GetPermissionEvaluator(nil)
+	require.Equal(t, createInvalidParameterError(OperationGetPermissionEvaluator, ParameterUser), err)
+
+	base := createFakeSling(func(r *http.Request) (int, string) {
+		switch r.URL.Path {
+		case "/api/users/Users-1/permissions":
+			return http.StatusOK, `{"Id":"Users-1","SystemPermissions":[],"SpacePermissions":{"DeploymentCreate":[{"SpaceId":"Spaces-1","RestrictedToProjectGroupIds":["ProjectGroups-1"]}]}}`
+		case "/api/Spaces-1/projects/all":
+			return http.StatusOK, `[{"Id":"Projects-1","ProjectGroupId":"ProjectGroups-1"}]`
+		}
+		return http.StatusNotFound, `{}`
+	})
+
+	client := &Client{
+		Projects: newProjectService(base, TestURIProjects, emptyString, emptyString),
+		Users:    newUserService(base, TestURIUsers, emptyString, emptyString, emptyString, emptyString, emptyString, emptyString, emptyString, emptyString, emptyString),
+	}
+
+	user := &User{resource: resource{ID: "Users-1", Links: map[string]string{linkPermissions: "/api/users/Users-1/permissions"}}}
+	evaluator, err := client.GetPermissionEvaluator(user)
+	require.NoError(t, err)
+	require.True(t, evaluator.Can("DeploymentCreate", "Spaces-1", "Projects-1", "Environments-1", emptyString))
+	require.False(t, evaluator.Can("DeploymentCreate", "Spaces-1", "Projects-2", "Environments-1", emptyString))
+
+	user.Links[linkPermissions] = "/api/users/Users-2/permissions"
+	_, err = client.GetPermissionEvaluator(user)
+	require.Error(t, err)
+}
@@ -0,0 +1,289 @@
+package octopusdeploy
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// everyoneTeamID is the ID of the built-in team that every user belongs
+	// to.
+	everyoneTeamID = "teams-everyone"
+
+	// permissionDeploymentCreate is the permission to deploy a release.
+	permissionDeploymentCreate = "DeploymentCreate"
+)
+
+// PermissionReport lists who holds a permission in an environment of a
+// space, and through which team and user role.
+type PermissionReport struct {
+	EnvironmentID string                  `json:"EnvironmentId"`
+	Items         []*PermissionReportItem `json:"Items"`
+	Permission    string                  `json:"Permission"`
+	SpaceID       string                  `json:"SpaceId"`
+}
+
+// PermissionReportItem is a user, or an external security group, that holds
+// the permission through a scoped user role. Empty project, project group
+// and tenant IDs mean that the grant is not restricted to any of them.
+type PermissionReportItem struct {
+	ExternalSecurityGroupID   string   `json:"ExternalSecurityGroupId,omitempty"`
+	ExternalSecurityGroupName string   `json:"ExternalSecurityGroupName,omitempty"`
+	IsActive                  bool     `json:"IsActive"`
+	IsService                 bool     `json:"IsService"`
+	ProjectGroupIDs           []string `json:"ProjectGroupIds"`
+	ProjectIDs                []string `json:"ProjectIds"`
+	ScopedUserRoleID          string   `json:"ScopedUserRoleId"`
+	TeamID                    string   `json:"TeamId"`
+	TeamName                  string   `json:"TeamName"`
+	TenantIDs                 []string `json:"TenantIds"`
+	UserID                    string   `json:"UserId,omitempty"`
+	Username                  string   `json:"Username,omitempty"`
+	UserRoleID                string   `json:"UserRoleId"`
+	UserRoleName              string   `json:"UserRoleName"`
+}
+
+// permissionReportColumns are the CSV columns written by WriteCSV.
+var permissionReportColumns = []string{
+	"Username",
+	"UserId",
+	"IsService",
+	"IsActive",
+	"ExternalSecurityGroupId",
+	"ExternalSecurityGroupName",
+	"TeamName",
+	"TeamId",
+	"UserRoleName",
+	"UserRoleId",
+	"ScopedUserRoleId",
+	"ProjectIds",
+	"ProjectGroupIds",
+	"TenantIds",
+}
+
+// BuildPermissionReport combines scoped user roles, user roles, teams and
+// users into the list of users who hold the permission in the environment of
+// the space. A scoped user role contributes if its scope covers the
+// environment and either it is for the space and its user role grants the
+// permission as a space permission, or it is for no space (a system team)
+// and its user role grants the permission as a system permission. Each
+// member of its team is listed, as is each external security group of the
+// team, whose members are not known to Octopus until they sign in. Every user
+// is a member of the built-in Everyone team. Items are sorted by username
+// and team name.
+func BuildPermissionReport(permission string, spaceID string, environmentID string, scopedUserRoles []*ScopedUserRole, userRoles []*UserRole, teams []*Team, users []*User) *PermissionReport {
+	report := &PermissionReport{
+		EnvironmentID: environmentID,
+		Items:         []*PermissionReportItem{},
+		Permission:    permission,
+		SpaceID:       spaceID,
+	}
+
+	userRolesByID := map[string]*UserRole{}
+	for _, userRole := range userRoles {
+		if userRole != nil {
+			userRolesByID[userRole.GetID()] = userRole
+		}
+	}
+
+	teamsByID := map[string]*Team{}
+	for _, team := range teams {
+		if team != nil {
+			teamsByID[team.GetID()] = team
+		}
+	}
+
+	usersByID := map[string]*User{}
+	for _, user := range users {
+		if user != nil {
+			usersByID[user.GetID()] = user
+		}
+	}
+
+	for _, scopedUserRole := range scopedUserRoles {
+		if scopedUserRole == nil {
+			continue
+		}
+
+		userRole, ok := userRolesByID[scopedUserRole.UserRoleID]
+		if !ok {
+			continue
+		}
+
+		if isEmpty(scopedUserRole.SpaceID) {
+			if !ValidateStringInSlice(permission, userRole.GrantedSystemPermissions) {
+				continue
+			}
+		} else if scopedUserRole.SpaceID != spaceID || !ValidateStringInSlice(permission, userRole.GrantedSpacePermissions) {
+			continue
+		}
+
+		if len(scopedUserRole.EnvironmentIDs) > 0 && !ValidateStringInSlice(environmentID, scopedUserRole.EnvironmentIDs) {
+			continue
+		}
+
+		team, ok := teamsByID[scopedUserRole.TeamID]
+		if !ok {
+			continue
+		}
+
+		newItem := func() *PermissionReportItem {
+			return &PermissionReportItem{
+				ProjectGroupIDs:  append([]string{}, scopedUserRole.ProjectGroupIDs...),
+				ProjectIDs:       append([]string{}, scopedUserRole.ProjectIDs...),
+				ScopedUserRoleID: scopedUserRole.GetID(),
+				TeamID:           team.GetID(),
+				TeamName:         team.Name,
+				TenantIDs:        append([]string{}, scopedUserRole.TenantIDs...),
+				UserRoleID:       userRole.GetID(),
+				UserRoleName:     userRole.Name,
+			}
+		}
+
+		memberUserIDs := team.MemberUserIDs
+		if team.GetID() == everyoneTeamID {
+			memberUserIDs = []string{}
+			for _, user := range users {
+				if user != nil {
+					memberUserIDs = append(memberUserIDs, user.GetID())
+				}
+			}
+		}
+
+		for _, userID := range memberUserIDs {
+			item := newItem()
+			item.UserID = userID
+			if user, ok := usersByID[userID]; ok {
+				item.IsActive = user.IsActive
+				item.IsService = user.IsService
+				item.Username = user.Username
+			}
+			report.Items = append(report.Items, item)
+		}
+
+		for _, group := range team.ExternalSecurityGroups {
+			if group == nil {
+				continue
+			}
+			item := newItem()
+			item.ExternalSecurityGroupID = group.ID
+			item.ExternalSecurityGroupName = group.DisplayName
+			report.Items = append(report.Items, item)
+		}
+	}
+
+	sort.SliceStable(report.Items, func(i, j int) bool {
+		a, b := report.Items[i], report.Items[j]
+		if a.Username != b.Username {
+			return a.Username < b.Username
+		}
+		if a.ExternalSecurityGroupName != b.ExternalSecurityGroupName {
+			return a.ExternalSecurityGroupName < b.ExternalSecurityGroupName
+		}
+		return a.TeamName < b.TeamName
+	})
+
+	return report
+}
+
+// GetUsernames returns the distinct usernames in the report, sorted.
+func (r *PermissionReport) GetUsernames() []string {
+	usernames := []string{}
+	for _, item := range r.Items {
+		if !isEmpty(item.Username) && !ValidateStringInSlice(item.Username, usernames) {
+			usernames = append(usernames, item.Username)
+		}
+	}
+	sort.Strings(usernames)
+	return usernames
+}
+
+// WriteCSV writes the report to the input writer as CSV with a header row.
+// Lists of IDs are separated by semicolons.
+func (r *PermissionReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(permissionReportColumns); err != nil {
+		return err
+	}
+
+	for _, item := range r.Items {
+		record := []string{
+			item.Username,
+			item.UserID,
+			strconv.FormatBool(item.IsService),
+			strconv.FormatBool(item.IsActive),
+			item.ExternalSecurityGroupID,
+			item.ExternalSecurityGroupName,
+			item.TeamName,
+			item.TeamID,
+			item.UserRoleName,
+			item.UserRoleID,
+			item.ScopedUserRoleID,
+			strings.Join(item.ProjectIDs, ";"),
+			strings.Join(item.ProjectGroupIDs, ";"),
+			strings.Join(item.TenantIDs, ";"),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteJSON writes the report to the input writer as indented JSON.
+func (r *PermissionReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent(empty, tab)
+	return encoder.Encode(r)
+}
+
+// GetPermissionReport loads the scoped user roles, user roles, teams and
+// users of the Octopus server and reports who holds the permission in the
+// environment of the space.
+func (c *Client) GetPermissionReport(permission string, spaceID string, environmentID string) (*PermissionReport, error) {
+	if isEmpty(permission) {
+		return nil, createInvalidParameterError(OperationGetPermissionReport, ParameterPermission)
+	}
+
+	if isEmpty(spaceID) {
+		return nil, createInvalidParameterError(OperationGetPermissionReport, ParameterSpaceID)
+	}
+
+	if isEmpty(environmentID) {
+		return nil, createInvalidParameterError(OperationGetPermissionReport, ParameterEnvironmentID)
+	}
+
+	scopedUserRoles, err := c.ScopedUserRoles.GetAll(ScopedUserRolesQuery{IncludeSystem: true})
+	if err != nil {
+		return nil, err
+	}
+
+	userRoles, err := c.UserRoles.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := c.Teams.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := c.Users.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return BuildPermissionReport(permission, spaceID, environmentID, scopedUserRoles, userRoles, teams, users), nil
+}
+
+// GetWhoCanDeploy reports who can create deployments to the environment of
+// the space.
+func (c *Client) GetWhoCanDeploy(spaceID string, environmentID string) (*PermissionReport, error) {
+	return c.GetPermissionReport(permissionDeploymentCreate, spaceID, environmentID)
+}
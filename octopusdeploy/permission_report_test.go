@@ -0,0 +1,81 @@
+package octopusdeploy
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildPermissionReport(t *testing.T) {
+	userRoles := []*UserRole{
+		{GrantedSpacePermissions: []string{"DeploymentCreate", "ProjectView"}, Name: "Deployer", resource: resource{ID: "userroles-deployer"}},
+		{GrantedSpacePermissions: []string{"ProjectView"}, Name: "Viewer", resource: resource{ID: "userroles-viewer"}},
+	}
+
+	teams := []*Team{
+		{MemberUserIDs: []string{"Users-1", "Users-2"}, Name: "Production Deployers", resource: resource{ID: "Teams-1"}},
+		{ExternalSecurityGroups: []*NamedReferenceItem{{DisplayName: "Release Managers", ID: "S-1-5-21-1"}}, Name: "Release Managers", resource: resource{ID: "Teams-2"}},
+		{MemberUserIDs: []string{"Users-3"}, Name: "Test Deployers", resource: resource{ID: "Teams-3"}},
+		{Name: "Everyone", resource: resource{ID: everyoneTeamID}},
+	}
+
+	users := []*User{
+		{IsActive: true, Username: "alice", resource: resource{ID: "Users-1"}},
+		{IsActive: true, IsService: true, Username: "ci", resource: resource{ID: "Users-2"}},
+		{IsActive: true, Username: "carol", resource: resource{ID: "Users-3"}},
+	}
+
+	scopedUserRoles := []*ScopedUserRole{
+		{EnvironmentIDs: []string{"Environments-3"}, ProjectIDs: []string{"Projects-1"}, SpaceID: "Spaces-1", TeamID: "Teams-1", UserRoleID: "userroles-deployer", resource: resource{ID: "ScopedUserRoles-1"}},
+		{SpaceID: "Spaces-1", TeamID: "Teams-2", UserRoleID: "userroles-deployer", resource: resource{ID: "ScopedUserRoles-2"}},
+		{EnvironmentIDs: []string{"Environments-2"}, SpaceID: "Spaces-1", TeamID: "Teams-3", UserRoleID: "userroles-deployer", resource: resource{ID: "ScopedUserRoles-3"}},
+		{SpaceID: "Spaces-2", TeamID: "Teams-3", UserRoleID: "userroles-deployer", resource: resource{ID: "ScopedUserRoles-4"}},
+		{SpaceID: "Spaces-1", TeamID: everyoneTeamID, UserRoleID: "userroles-viewer", resource: resource{ID: "ScopedUserRoles-5"}},
+	}
+
+	report := BuildPermissionReport("DeploymentCreate", "Spaces-1", "Environments-3", scopedUserRoles, userRoles, teams, users)
+	require.Len(t, report.Items, 3)
+	require.Equal(t, "Release Managers", report.Items[0].ExternalSecurityGroupName)
+	require.Empty(t, report.Items[0].Username)
+	require.Equal(t, "alice", report.Items[1].Username)
+	require.Equal(t, []string{"Projects-1"}, report.Items[1].ProjectIDs)
+	require.Equal(t, "Deployer", report.Items[1].UserRoleName)
+	require.Equal(t, "ci", report.Items[2].Username)
+	require.True(t, report.Items[2].IsService)
+	require.Equal(t, []string{"alice", "ci"}, report.GetUsernames())
+
+	// the Everyone team grants the permission to every user
+	report = BuildPermissionReport("ProjectView", "Spaces-1", "Environments-3", scopedUserRoles, userRoles, teams, users)
+	require.Equal(t, []string{"alice", "carol", "ci"}, report.GetUsernames())
+
+	// a system team only grants system permissions, even if its user role
+	// also grants space permissions
+	userRoles = append(userRoles, &UserRole{GrantedSpacePermissions: []string{"DeploymentCreate"}, GrantedSystemPermissions: []string{"SpaceView"}, Name: "System Deployer", resource: resource{ID: "userroles-system"}})
+	teams = append(teams, &Team{MemberUserIDs: []string{"Users-3"}, Name: "System Team", resource: resource{ID: "Teams-4"}})
+	systemScopedUserRoles := append(scopedUserRoles, &ScopedUserRole{TeamID: "Teams-4", UserRoleID: "userroles-system", resource: resource{ID: "ScopedUserRoles-6"}})
+	report = BuildPermissionReport("DeploymentCreate", "Spaces-1", "Environments-3", systemScopedUserRoles, userRoles, teams, users)
+	require.Equal(t, []string{"alice", "ci"}, report.GetUsernames())
+	report = BuildPermissionReport("SpaceView", "Spaces-1", "Environments-3", systemScopedUserRoles, userRoles, teams, users)
+	require.Equal(t, []string{"carol"}, report.GetUsernames())
+
+	report = BuildPermissionReport("ProjectView", "Spaces-1", "Environments-3", scopedUserRoles, userRoles, teams, users)
+	var buffer bytes.Buffer
+	require.NoError(t, report.WriteCSV(&buffer))
+	require.Contains(t, buffer.String(), "Username,UserId,IsService,IsActive")
+	require.Contains(t, buffer.String(), "alice,Users-1,false,true,,,Everyone,teams-everyone,Viewer,userroles-viewer,ScopedUserRoles-5,,,")
+
+	buffer.Reset()
+	require.NoError(t, report.WriteJSON(&buffer))
+	require.Contains(t, buffer.String(), `"Permission": "ProjectView"`)
+}
+
+func TestGetPermissionReportParameters(t *testing.T) {
+	c := &Client{}
+	_, err := c.GetPermissionReport(emptyString, "Spaces-1", "Environments-1")
+	require.Equal(t, createInvalidParameterError(OperationGetPermissionReport, ParameterPermission), err)
+	_, err = c.GetWhoCanDeploy(emptyString, "Environments-1")
+	require.Equal(t, createInvalidParameterError(OperationGetPermissionReport, ParameterSpaceID), err)
+	_, err = c.GetWhoCanDeploy("Spaces-1", emptyString)
+	require.Equal(t, createInvalidParameterError(OperationGetPermissionReport, ParameterEnvironmentID), err)
+}
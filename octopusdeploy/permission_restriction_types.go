@@ -0,0 +1,10 @@
+package octopusdeploy
+
+type PermissionRestriction string
+
+const (
+	PermissionRestrictionEnvironments  = PermissionRestriction("restrictable-to-environments")
+	PermissionRestrictionProjectGroups = PermissionRestriction("restrictable-to-projectgroups")
+	PermissionRestrictionProjects      = PermissionRestriction("restrictable-to-projects")
+	PermissionRestrictionTenants       = PermissionRestriction("restrictable-to-tenants")
+)
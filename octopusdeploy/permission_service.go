@@ -11,3 +11,16 @@ func newPermissionService(sling *sling.Sling, uriTemplate string) *permissionSer
 		service: newService(ServicePermissionService, sling, uriTemplate),
 	}
 }
+
+// GetAll returns the description of every permission, keyed by the name of
+// the permission.
+func (s permissionService) GetAll() (map[string]*PermissionDescription, error) {
+	items := map[string]*PermissionDescription{}
+	path, err := getPath(s)
+	if err != nil {
+		return items, err
+	}
+
+	_, err = apiGet(s.getClient(), &items, path)
+	return items, err
+}
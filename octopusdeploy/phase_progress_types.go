@@ -0,0 +1,10 @@
+package octopusdeploy
+
+// PhaseProgress is how far a release has progressed through a phase.
+type PhaseProgress string
+
+const (
+	PhaseProgressComplete = PhaseProgress("Complete")
+	PhaseProgressCurrent  = PhaseProgress("Current")
+	PhaseProgressPending  = PhaseProgress("Pending")
+)
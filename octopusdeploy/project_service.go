@@ -136,6 +136,31 @@ func (s projectService) GetChannels(project *Project) ([]*Channel, error) {
 	return channels, nil
 }
 
+// GetProgression returns the progression of the releases of the project
+// through the environments of their lifecycles, newest release first.
+func (s projectService) GetProgression(project *Project) (*Progression, error) {
+	if project == nil {
+		return nil, createInvalidParameterError(OperationGetProgression, ParameterProject)
+	}
+
+	err := validateInternalState(s)
+	if err != nil {
+		return nil, err
+	}
+
+	path := strings.Split(project.Links[linkProgression], "{")[0]
+	if isEmpty(path) {
+		return nil, createInvalidPathError(s.getName())
+	}
+
+	resp, err := apiGet(s.getClient(), new(Progression), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*Progression), nil
+}
+
 func (s projectService) GetSummary(project *Project) (*ProjectSummary, error) {
 	if project == nil {
 		return nil, createInvalidParameterError(OperationGetSummary, ParameterProject)
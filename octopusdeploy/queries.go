@@ -1,5 +1,7 @@
 package octopusdeploy
 
+import "time"
+
 // AccountsQuery represents parameters to query the Accounts service.
 type AccountsQuery struct {
 	AccountType AccountType `uri:"accountType,omitempty" url:"accountType,omitempty"`
@@ -130,6 +132,22 @@ type DeploymentsQuery struct {
 	Tenants      []string `uri:"tenants,omitempty" url:"tenants,omitempty"`
 }
 
+type DeploymentHistoryQuery struct {
+	EnvironmentIDs    []string   `uri:"environmentIds,omitempty" url:"environmentIds,omitempty"`
+	FromCompletedTime *time.Time `uri:"fromCompletedTime,omitempty" url:"fromCompletedTime,omitempty"`
+	ProjectIDs        []string   `uri:"projectIds,omitempty" url:"projectIds,omitempty"`
+	TenantIDs         []string   `uri:"tenantIds,omitempty" url:"tenantIds,omitempty"`
+	ToCompletedTime   *time.Time `uri:"toCompletedTime,omitempty" url:"toCompletedTime,omitempty"`
+}
+
+type DeploymentsCountedByWeekQuery struct {
+	EnvironmentIDs    []string   `uri:"environmentIds,omitempty" url:"environmentIds,omitempty"`
+	FromCompletedTime *time.Time `uri:"fromCompletedTime,omitempty" url:"fromCompletedTime,omitempty"`
+	ProjectIDs        []string   `uri:"projectIds,omitempty" url:"projectIds,omitempty"`
+	TenantIDs         []string   `uri:"tenantIds,omitempty" url:"tenantIds,omitempty"`
+	ToCompletedTime   *time.Time `uri:"toCompletedTime,omitempty" url:"toCompletedTime,omitempty"`
+}
+
 type DiscoverMachineQuery struct {
 	Host    string `uri:"host,omitempty" url:"host,omitempty"`
 	Port    int    `uri:"port,omitempty" url:"port,omitempty"`
@@ -432,6 +450,17 @@ type ScopedUserRolesQuery struct {
 	Take          int      `uri:"take,omitempty" url:"take,omitempty"`
 }
 
+type SearchPackageVersionsQuery struct {
+	Filter              string `uri:"filter,omitempty" url:"filter,omitempty"`
+	IncludePreRelease   bool   `uri:"includePreRelease,omitempty" url:"includePreRelease,omitempty"`
+	IncludeReleaseNotes bool   `uri:"includeReleaseNotes,omitempty" url:"includeReleaseNotes,omitempty"`
+	PackageID           string `uri:"packageId,omitempty" url:"packageId,omitempty"`
+	PreReleaseTag       string `uri:"preReleaseTag,omitempty" url:"preReleaseTag,omitempty"`
+	Skip                int    `uri:"skip,omitempty" url:"skip,omitempty"`
+	Take                int    `uri:"take,omitempty" url:"take,omitempty"`
+	VersionRange        string `uri:"versionRange,omitempty" url:"versionRange,omitempty"`
+}
+
 type SearchPackagesQuery struct {
 	Skip int    `uri:"skip,omitempty" url:"skip,omitempty"`
 	Take int    `uri:"take,omitempty" url:"take,omitempty"`
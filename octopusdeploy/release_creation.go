@@ -0,0 +1,303 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// CreateReleaseOptions configures CreateRelease.
+type CreateReleaseOptions struct {
+	// DryRun returns the plan without creating the release.
+	DryRun bool
+
+	// IgnoreChannelRules selects the latest version of every package
+	// regardless of the version rules of the channel.
+	IgnoreChannelRules bool
+
+	// PackageVersion is used for every package that has no override.
+	PackageVersion string
+
+	// PackageVersions overrides the version of individual packages. Keys are
+	// a step or action name, optionally followed by a colon and the name of
+	// the package reference, or a package ID.
+	PackageVersions map[string]string
+
+	// ReleaseNotes are the notes of the release.
+	ReleaseNotes string
+
+	// Version is the version of the release. If empty, it is computed from
+	// the versioning strategy of the project.
+	Version string
+}
+
+// ReleasePlan describes the release that CreateRelease creates, or would
+// create on a dry run.
+type ReleasePlan struct {
+	ChannelID string                `json:"ChannelId"`
+	Packages  []*ReleasePlanPackage `json:"Packages"`
+	ProjectID string                `json:"ProjectId"`
+	Release   *Release              `json:"Release,omitempty"`
+	Version   string                `json:"Version"`
+}
+
+// ReleasePlanPackage is the version selected for a package of the deployment
+// process, and the channel rule that constrained it.
+type ReleasePlanPackage struct {
+	ActionName           string `json:"ActionName"`
+	FeedID               string `json:"FeedId,omitempty"`
+	IsOverridden         bool   `json:"IsOverridden"`
+	PackageID            string `json:"PackageId"`
+	PackageReferenceName string `json:"PackageReferenceName,omitempty"`
+	StepName             string `json:"StepName"`
+	Tag                  string `json:"Tag,omitempty"`
+	Version              string `json:"Version"`
+	VersionRange         string `json:"VersionRange,omitempty"`
+}
+
+// GetSelectedPackages returns the packages of the plan as selected packages
+// of a release.
+func (p *ReleasePlan) GetSelectedPackages() []*SelectedPackage {
+	selectedPackages := []*SelectedPackage{}
+	for _, planPackage := range p.Packages {
+		selectedPackages = append(selectedPackages, &SelectedPackage{
+			ActionName:           planPackage.ActionName,
+			PackageReferenceName: planPackage.PackageReferenceName,
+			StepName:             planPackage.StepName,
+			Version:              planPackage.Version,
+		})
+	}
+	return selectedPackages
+}
+
+// WriteJSON writes the plan to the input writer as indented JSON.
+func (p *ReleasePlan) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent(empty, tab)
+	return encoder.Encode(p)
+}
+
+// getPackageVersionOverride returns the version given in the options for the
+// package, preferring the most specific key, or an empty string if there is
+// none.
+func (o CreateReleaseOptions) getPackageVersionOverride(templatePackage *ReleaseTemplatePackage) string {
+	keys := []string{}
+	if !isEmpty(templatePackage.PackageReferenceName) {
+		keys = append(keys,
+			templatePackage.ActionName+":"+templatePackage.PackageReferenceName,
+			templatePackage.StepName+":"+templatePackage.PackageReferenceName)
+	}
+	keys = append(keys, templatePackage.ActionName, templatePackage.StepName, templatePackage.PackageID)
+
+	for _, key := range keys {
+		for name, version := range o.PackageVersions {
+			if strings.EqualFold(name, key) && !isEmpty(version) {
+				return version
+			}
+		}
+	}
+
+	return o.PackageVersion
+}
+
+// getChannelRule returns the first rule of the channel that applies to the
+// step or action, or nil if there is none.
+func getChannelRule(channel *Channel, templatePackage *ReleaseTemplatePackage) *ChannelRule {
+	if channel == nil {
+		return nil
+	}
+
+	for i := range channel.Rules {
+		rule := &channel.Rules[i]
+		for _, action := range rule.Actions {
+			if strings.EqualFold(action, templatePackage.ActionName) || strings.EqualFold(action, templatePackage.StepName) {
+				return rule
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateRelease creates a release of the project in the channel, selecting a
+// version for every package of the deployment process. An empty channel ID
+// selects the default channel of the project.
+//
+// Package versions are taken from the overrides in the options. Otherwise
+// the latest version in the package's feed that satisfies the version range
+// and pre-release tag of the channel rule for its step is selected;
+// pre-release versions are only considered when the rule has a tag.
+//
+// If no release version is given, it is taken from the donor package of the
+// project's versioning strategy or, failing that, from the next version
+// computed by the server from the versioning template.
+//
+// On a dry run the plan is returned without creating the release. An error
+// is returned, along with the plan so far, if a version cannot be found for
+// any package.
+func (c *Client) CreateRelease(projectID string, channelID string, options CreateReleaseOptions) (*ReleasePlan, error) {
+	if isEmpty(projectID) {
+		return nil, createInvalidParameterError(OperationCreateRelease, ParameterProjectID)
+	}
+
+	project, err := c.Projects.GetByID(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := c.getReleaseChannel(project, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	deploymentProcess, err := c.DeploymentProcesses.GetByID(project.DeploymentProcessID)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := c.DeploymentProcesses.GetTemplate(deploymentProcess, channel.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &ReleasePlan{
+		ChannelID: channel.GetID(),
+		Packages:  []*ReleasePlanPackage{},
+		ProjectID: project.GetID(),
+	}
+
+	feeds := map[string]IFeed{}
+	unresolved := []string{}
+
+	for _, templatePackage := range template.Packages {
+		if templatePackage == nil {
+			continue
+		}
+
+		planPackage := &ReleasePlanPackage{
+			ActionName:           templatePackage.ActionName,
+			FeedID:               templatePackage.FeedID,
+			PackageID:            templatePackage.PackageID,
+			PackageReferenceName: templatePackage.PackageReferenceName,
+			StepName:             templatePackage.StepName,
+		}
+
+		if !options.IgnoreChannelRules {
+			if rule := getChannelRule(channel, templatePackage); rule != nil {
+				planPackage.Tag = rule.Tag
+				planPackage.VersionRange = rule.VersionRange
+			}
+		}
+
+		if version := options.getPackageVersionOverride(templatePackage); !isEmpty(version) {
+			planPackage.IsOverridden = true
+			planPackage.Version = version
+		} else if templatePackage.IsResolvable {
+			feed, ok := feeds[templatePackage.FeedID]
+			if !ok {
+				feed, err = c.Feeds.GetByID(templatePackage.FeedID)
+				if err != nil {
+					return plan, err
+				}
+				feeds[templatePackage.FeedID] = feed
+			}
+
+			packageVersions, err := c.Feeds.SearchPackageVersions(feed, SearchPackageVersionsQuery{
+				IncludePreRelease: !isEmpty(planPackage.Tag),
+				PackageID:         templatePackage.PackageID,
+				PreReleaseTag:     planPackage.Tag,
+				Take:              1,
+				VersionRange:      planPackage.VersionRange,
+			})
+			if err != nil {
+				return plan, err
+			}
+
+			if len(packageVersions.Items) > 0 {
+				planPackage.Version = packageVersions.Items[0].Version
+			}
+		}
+
+		if isEmpty(planPackage.Version) {
+			unresolved = append(unresolved, fmt.Sprintf("%s (step %s)", templatePackage.PackageID, templatePackage.StepName))
+		}
+
+		plan.Packages = append(plan.Packages, planPackage)
+	}
+
+	plan.Version = options.Version
+	if isEmpty(plan.Version) {
+		plan.Version = getReleaseVersion(project, template, plan)
+	}
+
+	if len(unresolved) > 0 {
+		return plan, fmt.Errorf("%s: no version could be selected for the packages %s", OperationCreateRelease, strings.Join(unresolved, ", "))
+	}
+
+	if isEmpty(plan.Version) {
+		return plan, fmt.Errorf("%s: the release version could not be computed for the project (%s)", OperationCreateRelease, project.Name)
+	}
+
+	if options.DryRun {
+		return plan, nil
+	}
+
+	release := NewRelease(plan.ChannelID, plan.ProjectID, plan.Version)
+	release.IgnoreChannelRules = options.IgnoreChannelRules
+	release.ReleaseNotes = options.ReleaseNotes
+	release.SelectedPackages = plan.GetSelectedPackages()
+
+	plan.Release, err = c.Releases.Add(release)
+	if err != nil {
+		return plan, err
+	}
+
+	return plan, nil
+}
+
+// getReleaseChannel returns the channel with the input ID, or the default
+// channel of the project if the ID is empty.
+func (c *Client) getReleaseChannel(project *Project, channelID string) (*Channel, error) {
+	if !isEmpty(channelID) {
+		channel, err := c.Channels.GetByID(channelID)
+		if err != nil {
+			return nil, err
+		}
+
+		if channel.ProjectID != project.GetID() {
+			return nil, fmt.Errorf("%s: the channel (%s) does not belong to the project (%s)", OperationCreateRelease, channelID, project.Name)
+		}
+
+		return channel, nil
+	}
+
+	channels, err := c.Projects.GetChannels(project)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, channel := range channels {
+		if channel.IsDefault {
+			return channel, nil
+		}
+	}
+
+	return nil, createResourceNotFoundError(ServiceChannelService, "default channel of project", project.Name)
+}
+
+// getReleaseVersion computes the release version from the versioning
+// strategy of the project: the version of the donor package if there is one,
+// otherwise the next version computed by the server.
+func getReleaseVersion(project *Project, template *ReleaseTemplate, plan *ReleasePlan) string {
+	if donorPackage := project.VersioningStrategy.DonorPackage; donorPackage != nil {
+		for _, planPackage := range plan.Packages {
+			if strings.EqualFold(planPackage.ActionName, donorPackage.DeploymentAction) && strings.EqualFold(planPackage.PackageReferenceName, donorPackage.PackageReference) {
+				return planPackage.Version
+			}
+		}
+		return empty
+	}
+
+	return template.NextVersionIncrement
+}
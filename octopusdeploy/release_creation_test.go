@@ -0,0 +1,194 @@
+package octopusdeploy
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeReleaseServer answers the requests made while creating a release.
+type fakeReleaseServer struct {
+	t *testing.T
+
+	created       *Release
+	project       string
+	versionQuery  map[string]string
+	versions      map[string]string
+	templateQuery string
+}
+
+func newFakeReleaseServer(t *testing.T) *fakeReleaseServer {
+	return &fakeReleaseServer{
+		t:            t,
+		project:      `{"Id":"Projects-1","Name":"Web","DeploymentProcessId":"deploymentprocess-Projects-1","VersioningStrategy":{"Template":"#{Octopus.Version.LastMajor}.#{Octopus.Version.LastMinor}.#{Octopus.Version.NextPatch}"},"Links":{"Channels":"/api/Spaces-1/projects/Projects-1/channels{/id}{?skip,take,partialName}"}}`,
+		versionQuery: map[string]string{},
+		versions: map[string]string{
+			"Web":    "2.1.0",
+			"Worker": "1.4.0-beta.2",
+		},
+	}
+}
+
+func (f *fakeReleaseServer) handle(r *http.Request) (int, string) {
+	switch {
+	case r.URL.Path == "/api/Spaces-1/projects/Projects-1":
+		return http.StatusOK, f.project
+	case r.URL.Path == "/api/Spaces-1/projects/Projects-1/channels":
+		return http.StatusOK, `{"Items":[{"Id":"Channels-2","ProjectId":"Projects-1"},{"Id":"Channels-1","IsDefault":true,"ProjectId":"Projects-1"}]}`
+	case r.URL.Path == "/api/Spaces-1/channels/Channels-1":
+		return http.StatusOK, `{"Id":"Channels-1","IsDefault":true,"ProjectId":"Projects-1"}`
+	case r.URL.Path == "/api/Spaces-1/channels/Channels-2":
+		return http.StatusOK, `{"Id":"Channels-2","ProjectId":"Projects-1","Rules":[{"Actions":["Deploy worker"],"Tag":"^beta","VersionRange":"[1.0,2.0)"}]}`
+	case r.URL.Path == "/api/Spaces-1/channels/Channels-3":
+		return http.StatusOK, `{"Id":"Channels-3","ProjectId":"Projects-2"}`
+	case r.URL.Path == "/api/Spaces-1/deploymentprocesses/deploymentprocess-Projects-1":
+		return http.StatusOK, `{"Id":"deploymentprocess-Projects-1","Links":{"Template":"/api/Spaces-1/deploymentprocesses/deploymentprocess-Projects-1/template{?channel,releaseId}"}}`
+	case r.URL.Path == "/api/Spaces-1/deploymentprocesses/deploymentprocess-Projects-1/template":
+		f.templateQuery = r.URL.RawQuery
+		return http.StatusOK, `{"NextVersionIncrement":"1.0.8","Packages":[
+			{"ActionName":"Deploy web","StepName":"Deploy web","PackageId":"Web","FeedId":"feeds-builtin","IsResolvable":true},
+			{"ActionName":"Deploy worker","StepName":"Deploy worker","PackageId":"Worker","PackageReferenceName":"worker","FeedId":"feeds-builtin","IsResolvable":true},
+			{"ActionName":"Run script","StepName":"Run script","PackageId":"Scripts","FeedId":"#{ScriptFeed}","IsResolvable":false}
+		]}`
+	case r.URL.Path == "/api/feeds/feeds-builtin":
+		return http.StatusOK, `{"Id":"feeds-builtin","FeedType":"BuiltIn","Name":"Built-in","Links":{"SearchPackageVersionsTemplate":"/api/feeds/feeds-builtin/packages/versions{?packageId,take,skip,includePreRelease,versionRange,preReleaseTag,filter,includeReleaseNotes}"}}`
+	case r.URL.Path == "/api/feeds/feeds-builtin/packages/versions":
+		packageID := r.URL.Query().Get("packageId")
+		f.versionQuery[packageID] = r.URL.RawQuery
+		if version, ok := f.versions[packageID]; ok {
+			return http.StatusOK, `{"Items":[{"PackageId":"` + packageID + `","Version":"` + version + `"}]}`
+		}
+		return http.StatusOK, `{"Items":[]}`
+	case r.Method == http.MethodPost && r.URL.Path == "/api/Spaces-1/releases":
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(f.t, err)
+		f.created = new(Release)
+		require.NoError(f.t, json.Unmarshal(body, f.created))
+		f.created.ID = "Releases-1"
+		data, err := json.Marshal(f.created)
+		require.NoError(f.t, err)
+		return http.StatusCreated, string(data)
+	}
+	return http.StatusNotFound, `{"ErrorMessage":"not found"}`
+}
+
+func (f *fakeReleaseServer) client() *Client {
+	base := createFakeSling(f.handle)
+	return &Client{
+		Channels:            newChannelService(base, TestURIChannels, emptyString),
+		DeploymentProcesses: newDeploymentProcessService(base, TestURIDeploymentProcesses),
+		Feeds:               newFeedService(base, TestURIFeeds, emptyString),
+		Projects:            newProjectService(base, TestURIProjects, emptyString, emptyString),
+		Releases:            newReleaseService(base, TestURIReleases),
+	}
+}
+
+func TestCreateReleaseDryRun(t *testing.T) {
+	server := newFakeReleaseServer(t)
+	client := server.client()
+
+	plan, err := client.CreateRelease("Projects-1", emptyString, CreateReleaseOptions{
+		DryRun:          true,
+		PackageVersions: map[string]string{"run script": "3.0.0"},
+	})
+	require.NoError(t, err)
+	require.Nil(t, server.created)
+	require.Equal(t, "Channels-1", plan.ChannelID)
+	require.Equal(t, "channel=Channels-1", server.templateQuery)
+	require.Equal(t, "1.0.8", plan.Version)
+
+	require.Len(t, plan.Packages, 3)
+	require.Equal(t, "2.1.0", plan.Packages[0].Version)
+	require.False(t, plan.Packages[0].IsOverridden)
+	require.Equal(t, "1.4.0-beta.2", plan.Packages[1].Version)
+	require.Equal(t, "3.0.0", plan.Packages[2].Version)
+	require.True(t, plan.Packages[2].IsOverridden)
+	require.Equal(t, "packageId=Web&take=1", server.versionQuery["Web"])
+
+	var buffer bytes.Buffer
+	require.NoError(t, plan.WriteJSON(&buffer))
+	require.Contains(t, buffer.String(), `"Version": "1.0.8"`)
+}
+
+func TestCreateRelease(t *testing.T) {
+	server := newFakeReleaseServer(t)
+	server.project = `{"Id":"Projects-1","Name":"Web","DeploymentProcessId":"deploymentprocess-Projects-1","VersioningStrategy":{"DonorPackage":{"DeploymentAction":"Deploy worker","PackageReference":"worker"}}}`
+	client := server.client()
+
+	plan, err := client.CreateRelease("Projects-1", "Channels-2", CreateReleaseOptions{
+		PackageVersions: map[string]string{"Run script": "3.0.0", "web": "2.0.5"},
+		ReleaseNotes:    "Quarterly release",
+	})
+	require.NoError(t, err)
+
+	// the channel rule constrains the worker package
+	require.Equal(t, "includePreRelease=true&packageId=Worker&preReleaseTag=%5Ebeta&take=1&versionRange=%5B1.0%2C2.0%29", server.versionQuery["Worker"])
+	require.Equal(t, "^beta", plan.Packages[1].Tag)
+	require.Equal(t, "[1.0,2.0)", plan.Packages[1].VersionRange)
+
+	// the release version comes from the donor package
+	require.Equal(t, "1.4.0-beta.2", plan.Version)
+
+	require.NotNil(t, plan.Release)
+	require.Equal(t, "Releases-1", plan.Release.GetID())
+	require.Equal(t, "Channels-2", server.created.ChannelID)
+	require.Equal(t, "Quarterly release", server.created.ReleaseNotes)
+	require.Equal(t, []*SelectedPackage{
+		{ActionName: "Deploy web", StepName: "Deploy web", Version: "2.0.5"},
+		{ActionName: "Deploy worker", PackageReferenceName: "worker", StepName: "Deploy worker", Version: "1.4.0-beta.2"},
+		{ActionName: "Run script", StepName: "Run script", Version: "3.0.0"},
+	}, server.created.SelectedPackages)
+}
+
+func TestCreateReleaseErrors(t *testing.T) {
+	c := &Client{}
+	_, err := c.CreateRelease(emptyString, emptyString, CreateReleaseOptions{})
+	require.Equal(t, createInvalidParameterError(OperationCreateRelease, ParameterProjectID), err)
+
+	server := newFakeReleaseServer(t)
+	client := server.client()
+
+	_, err = client.CreateRelease("Projects-1", "Channels-3", CreateReleaseOptions{})
+	require.EqualError(t, err, "CreateRelease: the channel (Channels-3) does not belong to the project (Web)")
+
+	// the script package cannot be resolved and the web package has no versions
+	delete(server.versions, "Web")
+	plan, err := client.CreateRelease("Projects-1", emptyString, CreateReleaseOptions{Version: "1.0.0"})
+	require.EqualError(t, err, "CreateRelease: no version could be selected for the packages Web (step Deploy web), Scripts (step Run script)")
+	require.Len(t, plan.Packages, 3)
+	require.Nil(t, server.created)
+}
+
+func TestFeedServiceSearchPackageVersions(t *testing.T) {
+	service := newFeedService(nil, TestURIFeeds, emptyString)
+	_, err := service.SearchPackageVersions(nil, SearchPackageVersionsQuery{PackageID: "Web"})
+	require.Equal(t, createInvalidParameterError(OperationSearchPackageVersions, ParameterFeed), err)
+
+	_, err = service.SearchPackageVersions(&FeedResource{}, SearchPackageVersionsQuery{})
+	require.Equal(t, createInvalidParameterError(OperationSearchPackageVersions, ParameterPackageID), err)
+
+	_, err = service.SearchPackageVersions(&FeedResource{}, SearchPackageVersionsQuery{PackageID: "Web"})
+	require.Equal(t, createInvalidPathError(service.getName()), err)
+}
+
+func TestDeploymentProcessServiceGetTemplate(t *testing.T) {
+	var requestURI string
+	base := createFakeSling(func(r *http.Request) (int, string) {
+		requestURI = r.URL.RequestURI()
+		return http.StatusOK, `{"NextVersionIncrement":"0.0.2","Packages":[]}`
+	})
+
+	service := newDeploymentProcessService(base, TestURIDeploymentProcesses)
+	_, err := service.GetTemplate(nil, emptyString)
+	require.Equal(t, createInvalidParameterError(OperationGetTemplate, ParameterDeploymentProcess), err)
+
+	deploymentProcess := &DeploymentProcess{resource: resource{ID: "deploymentprocess-Projects-1"}}
+	template, err := service.GetTemplate(deploymentProcess, emptyString)
+	require.NoError(t, err)
+	require.Equal(t, "0.0.2", template.NextVersionIncrement)
+	require.Equal(t, "/api/Spaces-1/deploymentprocesses/deploymentprocess-Projects-1/template", requestURI)
+}
@@ -0,0 +1,404 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// taskPollInterval is how often a task is checked while waiting for it to
+// complete.
+var taskPollInterval = 5 * time.Second
+
+// defaultMaxConcurrentWaits is the number of tasks that DeployRelease waits
+// on at once if the options do not say otherwise.
+const defaultMaxConcurrentWaits = 10
+
+// DeployReleaseOptions configures DeployRelease.
+type DeployReleaseOptions struct {
+	Comments             string
+	ExcludedMachineIDs   []string
+	ForcePackageDownload bool
+	FormValues           map[string]string
+
+	// MaxConcurrentWaits is the number of tasks that are waited on at once.
+	// If zero, 10 tasks are waited on at once.
+	MaxConcurrentWaits int
+
+	// NoWait returns as soon as the deployments are created, without waiting
+	// for their tasks to complete.
+	NoWait bool
+
+	// QueueTime schedules the deployments to start at a later time, and
+	// QueueTimeExpiry is the time after which they will no longer start.
+	QueueTime       *time.Time
+	QueueTimeExpiry *time.Time
+
+	SkipActions        []string
+	SpecificMachineIDs []string
+
+	// TenantIDs and TenantTags select the tenants to deploy to. A tenant is
+	// selected if it is listed by ID or matches the tags.
+	TenantIDs  []string
+	TenantTags []string
+
+	// Timeout is the maximum time to wait for all tasks to complete. If zero,
+	// there is no limit.
+	Timeout time.Duration
+
+	UseGuidedFailure bool
+}
+
+// DeployReleaseResult is the outcome of each deployment created by
+// DeployRelease.
+type DeployReleaseResult struct {
+	Outcomes  []*DeploymentOutcome `json:"Outcomes"`
+	ProjectID string               `json:"ProjectId"`
+	ReleaseID string               `json:"ReleaseId"`
+	Version   string               `json:"Version"`
+}
+
+// DeploymentOutcome is the state of a deployment of a release to an
+// environment, and optionally a tenant. An error message is set if the
+// deployment could not be created, its task failed, or waiting for the task
+// timed out.
+type DeploymentOutcome struct {
+	DeploymentID    string    `json:"DeploymentId,omitempty"`
+	EnvironmentID   string    `json:"EnvironmentId"`
+	EnvironmentName string    `json:"EnvironmentName"`
+	ErrorMessage    string    `json:"ErrorMessage,omitempty"`
+	State           TaskState `json:"State,omitempty"`
+	TaskID          string    `json:"TaskId,omitempty"`
+	TenantID        string    `json:"TenantId,omitempty"`
+	TenantName      string    `json:"TenantName,omitempty"`
+}
+
+// IsSuccessful returns true if the task of the deployment completed
+// successfully.
+func (o *DeploymentOutcome) IsSuccessful() bool {
+	return o.State == TaskStateSuccess
+}
+
+// GetFailed returns the outcomes of the deployments that were not created or
+// whose task did not complete successfully.
+func (r *DeployReleaseResult) GetFailed() []*DeploymentOutcome {
+	failed := []*DeploymentOutcome{}
+	for _, outcome := range r.Outcomes {
+		if !outcome.IsSuccessful() {
+			failed = append(failed, outcome)
+		}
+	}
+	return failed
+}
+
+// WriteJSON writes the result to the input writer as indented JSON.
+func (r *DeployReleaseResult) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent(empty, tab)
+	return encoder.Encode(r)
+}
+
+// WriteSummary writes the result to the input writer as text, one line per
+// deployment.
+func (r *DeployReleaseResult) WriteSummary(w io.Writer) error {
+	for _, outcome := range r.Outcomes {
+		target := outcome.EnvironmentName
+		if !isEmpty(outcome.TenantName) {
+			target = fmt.Sprintf("%s / %s", outcome.EnvironmentName, outcome.TenantName)
+		}
+
+		state := string(outcome.State)
+		if isEmpty(state) {
+			state = "NotCreated"
+		}
+
+		line := fmt.Sprintf("%s: %s", target, state)
+		if !isEmpty(outcome.TaskID) {
+			line += fmt.Sprintf(" (%s)", outcome.TaskID)
+		}
+		if !isEmpty(outcome.ErrorMessage) {
+			line += fmt.Sprintf(" - %s", outcome.ErrorMessage)
+		}
+
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeployRelease deploys the release to the input environments and waits for
+// the deployments to complete.
+//
+// If the options select tenants, one deployment is created for each tenant
+// in each environment to which the tenant is connected for the project of
+// the release; otherwise one deployment is created per environment. A
+// deployment that cannot be created does not stop the others from being
+// created.
+//
+// Unless the options say not to wait, the tasks of the deployments are then
+// polled until they complete, a limited number at a time. An error is
+// returned, along with the result, if any deployment was not created or did
+// not complete successfully.
+func (c *Client) DeployRelease(releaseID string, environmentIDs []string, options DeployReleaseOptions) (*DeployReleaseResult, error) {
+	if isEmpty(releaseID) {
+		return nil, createInvalidParameterError(OperationDeployRelease, ParameterReleaseID)
+	}
+
+	if len(environmentIDs) == 0 {
+		return nil, createInvalidParameterError(OperationDeployRelease, ParameterEnvironmentIDs)
+	}
+
+	if options.QueueTimeExpiry != nil && (options.QueueTime == nil || !options.QueueTimeExpiry.After(*options.QueueTime)) {
+		return nil, createInvalidParameterError(OperationDeployRelease, ParameterQueueTimeExpiry)
+	}
+
+	release, err := c.Releases.GetByID(releaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	environments, err := c.Environments.GetByIDs(environmentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	environmentNames := map[string]string{}
+	for _, environment := range environments {
+		environmentNames[environment.GetID()] = environment.Name
+	}
+
+	for _, environmentID := range environmentIDs {
+		if _, ok := environmentNames[environmentID]; !ok {
+			return nil, createResourceNotFoundError(ServiceEnvironmentService, "ID", environmentID)
+		}
+	}
+
+	tenants, err := c.getDeploymentTenants(release.ProjectID, options.TenantIDs, options.TenantTags)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DeployReleaseResult{
+		Outcomes:  []*DeploymentOutcome{},
+		ProjectID: release.ProjectID,
+		ReleaseID: release.GetID(),
+		Version:   release.Version,
+	}
+
+	for _, environmentID := range environmentIDs {
+		if tenants == nil {
+			result.Outcomes = append(result.Outcomes, &DeploymentOutcome{
+				EnvironmentID:   environmentID,
+				EnvironmentName: environmentNames[environmentID],
+			})
+			continue
+		}
+
+		for _, tenant := range tenants {
+			if ValidateStringInSlice(environmentID, tenant.ProjectEnvironments[release.ProjectID]) {
+				result.Outcomes = append(result.Outcomes, &DeploymentOutcome{
+					EnvironmentID:   environmentID,
+					EnvironmentName: environmentNames[environmentID],
+					TenantID:        tenant.GetID(),
+					TenantName:      tenant.Name,
+				})
+			}
+		}
+	}
+
+	if len(result.Outcomes) == 0 {
+		return result, fmt.Errorf("%s: none of the selected tenants are connected to the project (%s) in the environments", OperationDeployRelease, release.ProjectID)
+	}
+
+	for _, outcome := range result.Outcomes {
+		deployment := NewDeployment(emptyString, outcome.EnvironmentID, release.GetID())
+		deployment.ChannelID = release.ChannelID
+		deployment.Comments = options.Comments
+		deployment.ExcludedMachineIDs = options.ExcludedMachineIDs
+		deployment.ForcePackageDownload = options.ForcePackageDownload
+		deployment.FormValues = options.FormValues
+		deployment.ProjectID = release.ProjectID
+		deployment.QueueTime = options.QueueTime
+		deployment.QueueTimeExpiry = options.QueueTimeExpiry
+		deployment.SkipActions = options.SkipActions
+		deployment.SpecificMachineIDs = options.SpecificMachineIDs
+		deployment.TenantID = outcome.TenantID
+		deployment.UseGuidedFailure = options.UseGuidedFailure
+
+		deployment, err = c.Deployments.Add(deployment)
+		if err != nil {
+			outcome.ErrorMessage = err.Error()
+			continue
+		}
+
+		outcome.DeploymentID = deployment.GetID()
+		outcome.State = TaskStateQueued
+		outcome.TaskID = deployment.TaskID
+	}
+
+	if options.NoWait {
+		return result, nil
+	}
+
+	c.waitForDeployments(result.Outcomes, options.MaxConcurrentWaits, options.Timeout)
+
+	if failed := result.GetFailed(); len(failed) > 0 {
+		return result, fmt.Errorf("%s: %d of %d deployments of release %s did not complete successfully", OperationDeployRelease, len(failed), len(result.Outcomes), release.Version)
+	}
+
+	return result, nil
+}
+
+// getDeploymentTenants returns the tenants of the project that are listed by
+// ID or match the tags, or nil if neither IDs nor tags are given.
+func (c *Client) getDeploymentTenants(projectID string, tenantIDs []string, tenantTags []string) ([]*Tenant, error) {
+	if len(tenantIDs) == 0 && len(tenantTags) == 0 {
+		return nil, nil
+	}
+
+	tenants := []*Tenant{}
+
+	if len(tenantIDs) > 0 {
+		tenantsByID, err := c.Tenants.GetByIDs(tenantIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tenantID := range tenantIDs {
+			found := false
+			for _, tenant := range tenantsByID {
+				if tenant.GetID() == tenantID {
+					tenants = append(tenants, tenant)
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				return nil, createResourceNotFoundError(ServiceTenantService, "ID", tenantID)
+			}
+		}
+	}
+
+	if len(tenantTags) > 0 {
+		tenantsByTag, err := c.Tenants.Get(TenantsQuery{
+			ProjectID: projectID,
+			Tags:      tenantTags,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for {
+			for _, tenant := range tenantsByTag.Items {
+				if !ValidateStringInSlice(tenant.GetID(), tenantIDs) {
+					tenants = append(tenants, tenant)
+				}
+			}
+
+			path, loadNextPage := LoadNextPage(tenantsByTag.PagedResults)
+			if !loadNextPage {
+				break
+			}
+
+			resp, err := apiGet(c.Tenants.getClient(), new(Tenants), path)
+			if err != nil {
+				return nil, err
+			}
+			tenantsByTag = resp.(*Tenants)
+		}
+	}
+
+	return tenants, nil
+}
+
+// waitForDeployments waits for the tasks of the deployments to complete, at
+// most maxConcurrentWaits at a time, and records their final state.
+func (c *Client) waitForDeployments(outcomes []*DeploymentOutcome, maxConcurrentWaits int, timeout time.Duration) {
+	if maxConcurrentWaits <= 0 {
+		maxConcurrentWaits = defaultMaxConcurrentWaits
+	}
+
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	semaphore := make(chan struct{}, maxConcurrentWaits)
+	var waitGroup sync.WaitGroup
+
+	for _, outcome := range outcomes {
+		if isEmpty(outcome.TaskID) {
+			continue
+		}
+
+		waitGroup.Add(1)
+		go func(outcome *DeploymentOutcome) {
+			defer waitGroup.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			task, err := c.waitForTask(OperationDeployRelease, outcome.TaskID, timeout, deadline)
+			if task != nil {
+				outcome.State = task.State
+				outcome.ErrorMessage = task.ErrorMessage
+			}
+			if err != nil {
+				outcome.ErrorMessage = err.Error()
+			}
+		}(outcome)
+	}
+
+	waitGroup.Wait()
+}
+
+// WaitForTask polls the task until it completes and returns it. If the
+// timeout is not zero and elapses first, the task is returned along with an
+// error.
+func (c *Client) WaitForTask(taskID string, timeout time.Duration) (*Task, error) {
+	if isEmpty(taskID) {
+		return nil, createInvalidParameterError(OperationWaitForTask, ParameterTaskID)
+	}
+
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	return c.waitForTask(OperationWaitForTask, taskID, timeout, deadline)
+}
+
+// waitForTask polls the task until it completes and returns it. If the
+// deadline is not zero and passes first, the task is returned along with a
+// timeout error.
+func (c *Client) waitForTask(methodName string, taskID string, timeout time.Duration, deadline time.Time) (*Task, error) {
+	for {
+		task, err := c.Tasks.GetByID(taskID)
+		if err != nil {
+			return nil, err
+		}
+
+		if task.IsCompleted {
+			return task, nil
+		}
+
+		wait := taskPollInterval
+		if !deadline.IsZero() {
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				return task, createTimeoutError(methodName, timeout)
+			}
+
+			if remaining < wait {
+				wait = remaining
+			}
+		}
+
+		time.Sleep(wait)
+	}
+}
@@ -0,0 +1,203 @@
+package octopusdeploy
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDeploymentServer answers the requests made while deploying a release
+// and completes each task after it has been polled twice.
+type fakeDeploymentServer struct {
+	t *testing.T
+
+	deployments   []*Deployment
+	failedTenants []string
+	mutex         sync.Mutex
+	polls         map[string]int
+	running       map[string]bool
+	maxRunning    int
+	tenantQuery   string
+}
+
+func newFakeDeploymentServer(t *testing.T) *fakeDeploymentServer {
+	return &fakeDeploymentServer{
+		t:       t,
+		polls:   map[string]int{},
+		running: map[string]bool{},
+	}
+}
+
+func (f *fakeDeploymentServer) handle(r *http.Request) (int, string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	switch {
+	case r.URL.Path == "/api/Spaces-1/releases/Releases-1":
+		return http.StatusOK, `{"Id":"Releases-1","ChannelId":"Channels-1","ProjectId":"Projects-1","Version":"1.2.0"}`
+	case r.URL.Path == "/api/Spaces-1/environments":
+		return http.StatusOK, `{"Items":[{"Id":"Environments-1","Name":"Staging"},{"Id":"Environments-2","Name":"Production"}]}`
+	case r.URL.Path == "/api/Spaces-1/tenants" && r.URL.Query().Get("ids") != "":
+		return http.StatusOK, `{"Items":[{"Id":"Tenants-1","Name":"Acme","ProjectEnvironments":{"Projects-1":["Environments-1","Environments-2"]}}]}`
+	case r.URL.Path == "/api/Spaces-1/tenants":
+		f.tenantQuery = r.URL.RawQuery
+		return http.StatusOK, `{"Items":[
+			{"Id":"Tenants-1","Name":"Acme","ProjectEnvironments":{"Projects-1":["Environments-1","Environments-2"]}},
+			{"Id":"Tenants-2","Name":"Globex","ProjectEnvironments":{"Projects-1":["Environments-2"]}},
+			{"Id":"Tenants-3","Name":"Initech","ProjectEnvironments":{"Projects-2":["Environments-1"]}}
+		]}`
+	case r.Method == http.MethodPost && r.URL.Path == "/api/Spaces-1/deployments":
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(f.t, err)
+		deployment := new(Deployment)
+		require.NoError(f.t, json.Unmarshal(body, deployment))
+		if ValidateStringInSlice(deployment.TenantID, f.failedTenants) {
+			return http.StatusBadRequest, `{"ErrorMessage":"There was a problem with your request.","Errors":["The tenant is disabled."]}`
+		}
+		f.deployments = append(f.deployments, deployment)
+		n := len(f.deployments)
+		deployment.ID = "Deployments-" + string(rune('0'+n))
+		deployment.TaskID = "ServerTasks-" + string(rune('0'+n))
+		data, err := json.Marshal(deployment)
+		require.NoError(f.t, err)
+		return http.StatusCreated, string(data)
+	case strings.HasPrefix(r.URL.Path, "/api/tasks/"):
+		taskID := strings.TrimPrefix(r.URL.Path, "/api/tasks/")
+		f.polls[taskID]++
+		if f.polls[taskID] < 3 {
+			f.running[taskID] = true
+			if len(f.running) > f.maxRunning {
+				f.maxRunning = len(f.running)
+			}
+			return http.StatusOK, `{"Id":"` + taskID + `","State":"Executing"}`
+		}
+		delete(f.running, taskID)
+		if taskID == "ServerTasks-2" {
+			return http.StatusOK, `{"Id":"` + taskID + `","State":"Failed","IsCompleted":true,"ErrorMessage":"The deployment failed"}`
+		}
+		return http.StatusOK, `{"Id":"` + taskID + `","State":"Success","IsCompleted":true,"FinishedSuccessfully":true}`
+	}
+	return http.StatusNotFound, `{"ErrorMessage":"not found"}`
+}
+
+func (f *fakeDeploymentServer) client() *Client {
+	base := createFakeSling(f.handle)
+	return &Client{
+		Deployments:  newDeploymentService(base, TestURIDeployments),
+		Environments: newEnvironmentService(base, TestURIEnvironments, emptyString, emptyString),
+		Releases:     newReleaseService(base, TestURIReleases),
+		Tasks:        newTaskService(base, TestURITasks, TestURITaskTypes),
+		Tenants:      newTenantService(base, TestURITenants, emptyString, emptyString, emptyString),
+	}
+}
+
+// setTaskPollInterval sets how often tasks are checked and returns a function
+// that restores the previous interval.
+func setTaskPollInterval(interval time.Duration) func() {
+	previous := taskPollInterval
+	taskPollInterval = interval
+	return func() { taskPollInterval = previous }
+}
+
+func TestDeployRelease(t *testing.T) {
+	defer setTaskPollInterval(time.Millisecond)()
+
+	server := newFakeDeploymentServer(t)
+	client := server.client()
+
+	result, err := client.DeployRelease("Releases-1", []string{"Environments-1", "Environments-2"}, DeployReleaseOptions{
+		FormValues:         map[string]string{"Approver": "jo"},
+		MaxConcurrentWaits: 1,
+		SkipActions:        []string{"Actions-1"},
+		UseGuidedFailure:   true,
+	})
+	require.EqualError(t, err, "DeployRelease: 1 of 2 deployments of release 1.2.0 did not complete successfully")
+	require.Equal(t, 1, server.maxRunning)
+
+	require.Len(t, server.deployments, 2)
+	require.Equal(t, "Environments-1", *server.deployments[0].EnvironmentID)
+	require.Equal(t, "Releases-1", *server.deployments[0].ReleaseID)
+	require.Equal(t, "Projects-1", server.deployments[0].ProjectID)
+	require.Equal(t, map[string]string{"Approver": "jo"}, server.deployments[0].FormValues)
+	require.Equal(t, []string{"Actions-1"}, server.deployments[0].SkipActions)
+	require.True(t, server.deployments[0].UseGuidedFailure)
+
+	require.Len(t, result.Outcomes, 2)
+	require.True(t, result.Outcomes[0].IsSuccessful())
+	require.Equal(t, "Deployments-1", result.Outcomes[0].DeploymentID)
+	require.Equal(t, TaskStateFailed, result.Outcomes[1].State)
+	require.Equal(t, "The deployment failed", result.Outcomes[1].ErrorMessage)
+	require.Equal(t, []*DeploymentOutcome{result.Outcomes[1]}, result.GetFailed())
+
+	var buffer bytes.Buffer
+	require.NoError(t, result.WriteSummary(&buffer))
+	require.Equal(t, "Staging: Success (ServerTasks-1)\nProduction: Failed (ServerTasks-2) - The deployment failed\n", buffer.String())
+}
+
+func TestDeployReleaseToTenants(t *testing.T) {
+	defer setTaskPollInterval(time.Millisecond)()
+
+	server := newFakeDeploymentServer(t)
+	server.failedTenants = []string{"Tenants-1"}
+	client := server.client()
+
+	queueTime := time.Date(2020, 10, 1, 22, 0, 0, 0, time.UTC)
+	queueTimeExpiry := queueTime.Add(time.Hour)
+
+	result, err := client.DeployRelease("Releases-1", []string{"Environments-2"}, DeployReleaseOptions{
+		NoWait:          true,
+		QueueTime:       &queueTime,
+		QueueTimeExpiry: &queueTimeExpiry,
+		TenantIDs:       []string{"Tenants-1"},
+		TenantTags:      []string{"Region/EU"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "projectId=Projects-1&tags=Region%2FEU", server.tenantQuery)
+	require.Empty(t, server.polls)
+
+	// Initech is not connected to the project
+	require.Len(t, result.Outcomes, 2)
+	require.Equal(t, "Acme", result.Outcomes[0].TenantName)
+	require.NotEmpty(t, result.Outcomes[0].ErrorMessage)
+	require.Empty(t, result.Outcomes[0].State)
+	require.Equal(t, "Globex", result.Outcomes[1].TenantName)
+	require.Equal(t, TaskStateQueued, result.Outcomes[1].State)
+
+	require.Len(t, server.deployments, 1)
+	require.Equal(t, "Tenants-2", server.deployments[0].TenantID)
+	require.Equal(t, queueTime, server.deployments[0].QueueTime.UTC())
+	require.Equal(t, queueTimeExpiry, server.deployments[0].QueueTimeExpiry.UTC())
+}
+
+func TestDeployReleaseErrors(t *testing.T) {
+	defer setTaskPollInterval(time.Millisecond)()
+
+	c := &Client{}
+	_, err := c.DeployRelease(emptyString, []string{"Environments-1"}, DeployReleaseOptions{})
+	require.Equal(t, createInvalidParameterError(OperationDeployRelease, ParameterReleaseID), err)
+
+	_, err = c.DeployRelease("Releases-1", nil, DeployReleaseOptions{})
+	require.Equal(t, createInvalidParameterError(OperationDeployRelease, ParameterEnvironmentIDs), err)
+
+	queueTimeExpiry := time.Now()
+	_, err = c.DeployRelease("Releases-1", []string{"Environments-1"}, DeployReleaseOptions{QueueTimeExpiry: &queueTimeExpiry})
+	require.Equal(t, createInvalidParameterError(OperationDeployRelease, ParameterQueueTimeExpiry), err)
+
+	server := newFakeDeploymentServer(t)
+	client := server.client()
+
+	_, err = client.DeployRelease("Releases-1", []string{"Environments-3"}, DeployReleaseOptions{})
+	require.Equal(t, createResourceNotFoundError(ServiceEnvironmentService, "ID", "Environments-3"), err)
+
+	result, err := client.DeployRelease("Releases-1", []string{"Environments-1"}, DeployReleaseOptions{Timeout: time.Nanosecond})
+	require.Error(t, err)
+	require.Equal(t, TaskStateExecuting, result.Outcomes[0].State)
+	require.Equal(t, createTimeoutError(OperationDeployRelease, time.Nanosecond).Error(), result.Outcomes[0].ErrorMessage)
+}
@@ -0,0 +1,201 @@
+package octopusdeploy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// DefaultReleaseNotesTemplate is the text/template used to render release
+// notes as Markdown when no template is given.
+const DefaultReleaseNotesTemplate = `# {{ .ProjectName }} {{ if .FromVersion }}{{ .FromVersion }} to {{ end }}{{ .ToVersion }}
+{{ range .Releases }}
+## {{ .Version }}
+{{ if .ReleaseNotes }}
+{{ .ReleaseNotes }}
+{{ end }}{{ end }}{{ if .WorkItems }}
+## Work items
+{{ range .WorkItems }}
+- {{ if .LinkURL }}[{{ .ID }}]({{ .LinkURL }}){{ else }}{{ .ID }}{{ end }}{{ if .Description }} {{ .Description }}{{ end }}{{ end }}
+{{ end }}{{ if .Commits }}
+## Commits
+{{ range .Commits }}
+- {{ if .LinkURL }}[{{ .ID }}]({{ .LinkURL }}){{ else }}{{ .ID }}{{ end }}{{ if .Comment }} {{ .Comment }}{{ end }}{{ end }}
+{{ end }}`
+
+// ReleaseNotes collects the releases of a project between two versions,
+// with the commits and work items of their packages. Commits and work items
+// that appear in several packages or releases are listed once.
+type ReleaseNotes struct {
+	Commits     []*CommitDetails       `json:"Commits"`
+	FromVersion string                 `json:"FromVersion,omitempty"`
+	ProjectID   string                 `json:"ProjectId"`
+	ProjectName string                 `json:"ProjectName"`
+	Releases    []*ReleaseNotesRelease `json:"Releases"`
+	Text        string                 `json:"Text,omitempty"`
+	ToVersion   string                 `json:"ToVersion"`
+	WorkItems   []*WorkItemLink        `json:"WorkItems"`
+}
+
+// ReleaseNotesRelease is a release included in release notes.
+type ReleaseNotesRelease struct {
+	Assembled        time.Time                                `json:"Assembled"`
+	BuildInformation []*ReleasePackageVersionBuildInformation `json:"BuildInformation"`
+	ID               string                                   `json:"Id"`
+	ReleaseNotes     string                                   `json:"ReleaseNotes,omitempty"`
+	Version          string                                   `json:"Version"`
+}
+
+// BuildReleaseNotes collects the releases of the project that are later
+// than the from version and no later than the to version, newest first.
+// Versions are compared as semantic versions. An empty from version includes
+// every release up to the to version, and an empty to version every release
+// from the from version up to the latest. Commits are de-duplicated by ID
+// and work items by source and ID, and are listed in the order they are
+// first found, newest release first.
+func BuildReleaseNotes(project *Project, releases []*Release, fromVersion string, toVersion string) *ReleaseNotes {
+	notes := &ReleaseNotes{
+		Commits:     []*CommitDetails{},
+		FromVersion: fromVersion,
+		ProjectID:   project.GetID(),
+		ProjectName: project.Name,
+		Releases:    []*ReleaseNotesRelease{},
+		ToVersion:   toVersion,
+		WorkItems:   []*WorkItemLink{},
+	}
+
+	included := []*Release{}
+	for _, release := range releases {
+		if release == nil {
+			continue
+		}
+
+		if !isEmpty(fromVersion) && compareReleaseVersions(release.Version, fromVersion) <= 0 {
+			continue
+		}
+
+		if !isEmpty(toVersion) && compareReleaseVersions(release.Version, toVersion) > 0 {
+			continue
+		}
+
+		included = append(included, release)
+	}
+
+	sort.SliceStable(included, func(i, j int) bool {
+		return compareReleaseVersions(included[i].Version, included[j].Version) > 0
+	})
+
+	if isEmpty(notes.ToVersion) && len(included) > 0 {
+		notes.ToVersion = included[0].Version
+	}
+
+	commitKeys := []string{}
+	workItemKeys := []string{}
+
+	for _, release := range included {
+		notes.Releases = append(notes.Releases, &ReleaseNotesRelease{
+			Assembled:        release.Assembled,
+			BuildInformation: release.BuildInformation,
+			ID:               release.GetID(),
+			ReleaseNotes:     strings.TrimSpace(release.ReleaseNotes),
+			Version:          release.Version,
+		})
+
+		for _, buildInformation := range release.BuildInformation {
+			if buildInformation == nil {
+				continue
+			}
+
+			for _, commit := range buildInformation.Commits {
+				if commit == nil {
+					continue
+				}
+
+				key := commit.ID
+				if isEmpty(key) {
+					key = commit.Comment
+				}
+
+				if !ValidateStringInSlice(key, commitKeys) {
+					commitKeys = append(commitKeys, key)
+					notes.Commits = append(notes.Commits, commit)
+				}
+			}
+
+			for _, workItem := range buildInformation.WorkItems {
+				if workItem == nil {
+					continue
+				}
+
+				key := workItem.Source + "/" + workItem.ID
+				if !ValidateStringInSlice(key, workItemKeys) {
+					workItemKeys = append(workItemKeys, key)
+					notes.WorkItems = append(notes.WorkItems, workItem)
+				}
+			}
+		}
+	}
+
+	return notes
+}
+
+// Render writes the release notes to the input writer through the
+// text/template, or through DefaultReleaseNotesTemplate if it is empty.
+func (n *ReleaseNotes) Render(w io.Writer, text string) error {
+	if isEmpty(text) {
+		text = DefaultReleaseNotesTemplate
+	}
+
+	releaseNotesTemplate, err := template.New("ReleaseNotes").Parse(text)
+	if err != nil {
+		return err
+	}
+
+	return releaseNotesTemplate.Execute(w, n)
+}
+
+// WriteJSON writes the release notes to the input writer as indented JSON.
+func (n *ReleaseNotes) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent(empty, tab)
+	return encoder.Encode(n)
+}
+
+// GenerateReleaseNotes collects the releases of the project between the two
+// versions as BuildReleaseNotes does and renders them through the
+// text/template, or DefaultReleaseNotesTemplate if it is empty, into the
+// Text of the release notes. An error is returned if no release is between
+// the versions.
+func (c *Client) GenerateReleaseNotes(projectID string, fromVersion string, toVersion string, text string) (*ReleaseNotes, error) {
+	if isEmpty(projectID) {
+		return nil, createInvalidParameterError(OperationGenerateReleaseNotes, ParameterProjectID)
+	}
+
+	project, err := c.Projects.GetByID(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	releases, err := c.Projects.GetReleases(project)
+	if err != nil {
+		return nil, err
+	}
+
+	notes := BuildReleaseNotes(project, releases, fromVersion, toVersion)
+	if len(notes.Releases) == 0 {
+		return notes, fmt.Errorf("%s: the project (%s) has no releases later than %q and no later than %q", OperationGenerateReleaseNotes, project.Name, fromVersion, toVersion)
+	}
+
+	var buffer bytes.Buffer
+	if err := notes.Render(&buffer, text); err != nil {
+		return notes, err
+	}
+	notes.Text = buffer.String()
+
+	return notes, nil
+}
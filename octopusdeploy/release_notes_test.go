@@ -0,0 +1,165 @@
+package octopusdeploy
+
+import (
+	"bytes"
+	"net/http"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareReleaseVersions(t *testing.T) {
+	testCases := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{"1.0.0", "1.0.0", 0},
+		{"1.0.10", "1.0.9", 1},
+		{"1.0", "1.0.0", 0},
+		{"1.0.0.1", "1.0.0", 1},
+		{"1.0.0-beta.2", "1.0.0-beta.10", -1},
+		{"1.0.0-beta", "1.0.0-beta.1", -1},
+		{"1.0.0-1", "1.0.0-alpha", -1},
+		{"1.0.0-rc.1", "1.0.0", -1},
+		{"1.0.0+build.5", "1.0.0", 0},
+		{"latest", "0.0.1", -1},
+		{"a", "b", -1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.a+"/"+tc.b, func(t *testing.T) {
+			require.Equal(t, tc.expected, compareReleaseVersions(tc.a, tc.b))
+			require.Equal(t, -tc.expected, compareReleaseVersions(tc.b, tc.a))
+		})
+	}
+
+	// versions that cannot be parsed are sorted below every valid version
+	versions := []string{"nightly", "1.0.10", "2.0.0-rc.1", "build-5", "1.0.9"}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return compareReleaseVersions(versions[i], versions[j]) > 0
+	})
+	require.Equal(t, []string{"2.0.0-rc.1", "1.0.10", "1.0.9", "nightly", "build-5"}, versions)
+}
+
+func newTestReleasesWithBuildInformation() []*Release {
+	release1 := NewRelease("Channels-1", "Projects-1", "1.0.9")
+	release1.ID = "Releases-1"
+	release1.BuildInformation = []*ReleasePackageVersionBuildInformation{
+		{PackageID: "Web", Commits: []*CommitDetails{{ID: "a1", Comment: "Initial commit"}}},
+	}
+
+	release2 := NewRelease("Channels-1", "Projects-1", "1.0.10")
+	release2.ID = "Releases-2"
+	release2.ReleaseNotes = "Adds the login page.\n"
+	release2.BuildInformation = []*ReleasePackageVersionBuildInformation{
+		{
+			PackageID: "Web",
+			Commits: []*CommitDetails{
+				{ID: "b2", Comment: "Add login page", LinkURL: "https://git.example.com/b2"},
+				{ID: "a1", Comment: "Initial commit"},
+			},
+			WorkItems: []*WorkItemLink{{ID: "WEB-2", Description: "Login page", LinkURL: "https://jira.example.com/WEB-2", Source: "Jira"}},
+		},
+		{
+			PackageID: "Worker",
+			Commits:   []*CommitDetails{{ID: "b2", Comment: "Add login page", LinkURL: "https://git.example.com/b2"}},
+			WorkItems: []*WorkItemLink{{ID: "WEB-2", Description: "Login page", LinkURL: "https://jira.example.com/WEB-2", Source: "Jira"}},
+		},
+	}
+
+	release3 := NewRelease("Channels-1", "Projects-1", "1.1.0-beta.1")
+	release3.ID = "Releases-3"
+	release3.BuildInformation = []*ReleasePackageVersionBuildInformation{
+		{
+			PackageID: "Web",
+			Commits:   []*CommitDetails{{ID: "c3", Comment: "Fix logout"}},
+			WorkItems: []*WorkItemLink{{ID: "WEB-3", Source: "Jira"}, {ID: "WEB-2", Source: "GitHub"}},
+		},
+	}
+
+	release4 := NewRelease("Channels-1", "Projects-1", "1.1.0")
+	release4.ID = "Releases-4"
+
+	return []*Release{release4, release3, release1, release2}
+}
+
+func TestBuildReleaseNotes(t *testing.T) {
+	project := &Project{Name: "Web", resource: resource{ID: "Projects-1"}}
+	releases := newTestReleasesWithBuildInformation()
+
+	notes := BuildReleaseNotes(project, releases, "1.0.0", "1.1.0-beta.1")
+	require.Equal(t, "1.1.0-beta.1", notes.ToVersion)
+	require.Len(t, notes.Releases, 3)
+	require.Equal(t, "1.1.0-beta.1", notes.Releases[0].Version)
+	require.Equal(t, "1.0.10", notes.Releases[1].Version)
+	require.Equal(t, "1.0.9", notes.Releases[2].Version)
+
+	require.Equal(t, []*CommitDetails{
+		{ID: "c3", Comment: "Fix logout"},
+		{ID: "b2", Comment: "Add login page", LinkURL: "https://git.example.com/b2"},
+		{ID: "a1", Comment: "Initial commit"},
+	}, notes.Commits)
+	require.Len(t, notes.WorkItems, 3)
+
+	notes = BuildReleaseNotes(project, releases, "1.0.9", emptyString)
+	require.Equal(t, "1.1.0", notes.ToVersion)
+	require.Len(t, notes.Releases, 3)
+	require.Equal(t, "1.1.0", notes.Releases[0].Version)
+
+	var buffer bytes.Buffer
+	notes = BuildReleaseNotes(project, releases, "1.0.9", "1.0.10")
+	require.NoError(t, notes.Render(&buffer, emptyString))
+	require.Equal(t, `# Web 1.0.9 to 1.0.10
+
+## 1.0.10
+
+Adds the login page.
+
+## Work items
+
+- [WEB-2](https://jira.example.com/WEB-2) Login page
+
+## Commits
+
+- [b2](https://git.example.com/b2) Add login page
+- a1 Initial commit
+`, buffer.String())
+
+	buffer.Reset()
+	require.NoError(t, notes.Render(&buffer, "{{ range .Commits }}{{ .ID }} {{ end }}"))
+	require.Equal(t, "b2 a1 ", buffer.String())
+
+	require.Error(t, notes.Render(&buffer, "{{ .Missing"))
+
+	buffer.Reset()
+	require.NoError(t, notes.WriteJSON(&buffer))
+	require.Contains(t, buffer.String(), `"ToVersion": "1.0.10"`)
+}
+
+func TestGenerateReleaseNotes(t *testing.T) {
+	base := createFakeSling(func(r *http.Request) (int, string) {
+		switch r.URL.Path {
+		case "/api/Spaces-1/projects/Projects-1":
+			return http.StatusOK, `{"Id":"Projects-1","Name":"Web","Links":{"Releases":"/api/Spaces-1/projects/Projects-1/releases{/version}{?skip,take,searchByVersion}"}}`
+		case "/api/Spaces-1/projects/Projects-1/releases":
+			return http.StatusOK, `{"Items":[
+				{"Id":"Releases-2","Version":"1.0.1","BuildInformation":[{"PackageId":"Web","Commits":[{"Id":"b2","Comment":"Fix"}]}]},
+				{"Id":"Releases-1","Version":"1.0.0"}]}`
+		}
+		return http.StatusNotFound, `{"ErrorMessage":"not found"}`
+	})
+
+	client := &Client{Projects: newProjectService(base, TestURIProjects, emptyString, emptyString)}
+
+	notes, err := client.GenerateReleaseNotes("Projects-1", "1.0.0", emptyString, "{{ .ToVersion }}:{{ range .Commits }} {{ .Comment }}{{ end }}")
+	require.NoError(t, err)
+	require.Equal(t, "1.0.1: Fix", notes.Text)
+
+	_, err = client.GenerateReleaseNotes("Projects-1", "1.0.1", emptyString, emptyString)
+	require.EqualError(t, err, `GenerateReleaseNotes: the project (Web) has no releases later than "1.0.1" and no later than ""`)
+
+	_, err = client.GenerateReleaseNotes(emptyString, emptyString, emptyString, emptyString)
+	require.Equal(t, createInvalidParameterError(OperationGenerateReleaseNotes, ParameterProjectID), err)
+}
@@ -0,0 +1,234 @@
+package octopusdeploy
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrPromotionBlocked is matched by errors returned when the lifecycle of a
+// release does not allow it to be deployed to an environment. Use errors.Is
+// to check for it.
+var ErrPromotionBlocked = errors.New("the promotion is not allowed by the lifecycle")
+
+// PromotionBlockedReason is why the lifecycle does not allow a promotion.
+type PromotionBlockedReason string
+
+const (
+	PromotionBlockedReasonEnvironmentNotInLifecycle PromotionBlockedReason = "EnvironmentNotInLifecycle"
+	PromotionBlockedReasonPhaseIncomplete           PromotionBlockedReason = "PhaseIncomplete"
+)
+
+// PromotionBlockedError describes why a release cannot be deployed to an
+// environment. For an incomplete phase, it names the phase and how many of
+// its environments have, and need, a successful deployment.
+type PromotionBlockedError struct {
+	BlockingPhase         string
+	CompletedEnvironments int
+	EnvironmentID         string
+	LifecycleName         string
+	Reason                PromotionBlockedReason
+	RequiredEnvironments  int
+}
+
+func (e *PromotionBlockedError) Error() string {
+	if e.Reason == PromotionBlockedReasonEnvironmentNotInLifecycle {
+		return fmt.Sprintf("%s: the environment (%s) is not in any phase of the lifecycle (%s)", ErrPromotionBlocked, e.EnvironmentID, e.LifecycleName)
+	}
+
+	return fmt.Sprintf("%s: the phase %s of the lifecycle (%s) must be complete before deploying to the environment (%s); %d of %d required environments have a successful deployment", ErrPromotionBlocked, e.BlockingPhase, e.LifecycleName, e.EnvironmentID, e.CompletedEnvironments, e.RequiredEnvironments)
+}
+
+// Is returns true if the target is ErrPromotionBlocked.
+func (e *PromotionBlockedError) Is(target error) bool {
+	return target == ErrPromotionBlocked
+}
+
+// getEnvironmentIDs returns the automatic and optional deployment targets of
+// the phase.
+func (p Phase) getEnvironmentIDs() []string {
+	environmentIDs := append([]string{}, p.AutomaticDeploymentTargets...)
+	for _, environmentID := range p.OptionalDeploymentTargets {
+		if !ValidateStringInSlice(environmentID, environmentIDs) {
+			environmentIDs = append(environmentIDs, environmentID)
+		}
+	}
+	return environmentIDs
+}
+
+// getCompletion returns how many environments of the phase are among the
+// successful environments, and how many must be for the phase to be
+// complete: MinimumEnvironmentsBeforePromotion if it is set, otherwise all
+// of them.
+func (p Phase) getCompletion(successfulEnvironmentIDs []string) (int, int) {
+	environmentIDs := p.getEnvironmentIDs()
+
+	completed := 0
+	for _, environmentID := range environmentIDs {
+		if ValidateStringInSlice(environmentID, successfulEnvironmentIDs) {
+			completed++
+		}
+	}
+
+	required := len(environmentIDs)
+	if p.MinimumEnvironmentsBeforePromotion > 0 && int(p.MinimumEnvironmentsBeforePromotion) < required {
+		required = int(p.MinimumEnvironmentsBeforePromotion)
+	}
+
+	return completed, required
+}
+
+// CheckPromotion returns nil if a release that has been deployed
+// successfully to the input environments may be deployed to the target
+// environment. The target must be in a phase of the lifecycle, and every
+// earlier phase that is not optional must be complete. A lifecycle without
+// phases allows every environment. Otherwise a *PromotionBlockedError is
+// returned.
+func (l *Lifecycle) CheckPromotion(successfulEnvironmentIDs []string, environmentID string) error {
+	if len(l.Phases) == 0 {
+		return nil
+	}
+
+	targetPhase := -1
+	for i, phase := range l.Phases {
+		if ValidateStringInSlice(environmentID, phase.getEnvironmentIDs()) {
+			targetPhase = i
+			break
+		}
+	}
+
+	if targetPhase < 0 {
+		return &PromotionBlockedError{
+			EnvironmentID: environmentID,
+			LifecycleName: l.Name,
+			Reason:        PromotionBlockedReasonEnvironmentNotInLifecycle,
+		}
+	}
+
+	for _, phase := range l.Phases[:targetPhase] {
+		if phase.IsOptionalPhase {
+			continue
+		}
+
+		completed, required := phase.getCompletion(successfulEnvironmentIDs)
+		if completed < required {
+			return &PromotionBlockedError{
+				BlockingPhase:         phase.Name,
+				CompletedEnvironments: completed,
+				EnvironmentID:         environmentID,
+				LifecycleName:         l.Name,
+				Reason:                PromotionBlockedReasonPhaseIncomplete,
+				RequiredEnvironments:  required,
+			}
+		}
+	}
+
+	return nil
+}
+
+// getSuccessfulEnvironmentIDs returns the environments to which the release
+// has been deployed successfully.
+func (p *ReleaseProgression) getSuccessfulEnvironmentIDs() []string {
+	environmentIDs := []string{}
+	for environmentID, items := range p.Deployments {
+		for _, item := range items {
+			if item.State == string(TaskStateSuccess) {
+				environmentIDs = append(environmentIDs, environmentID)
+				break
+			}
+		}
+	}
+	return environmentIDs
+}
+
+// getLatestSuccessfulRelease returns the release progression whose
+// deployment to the environment completed successfully most recently, or nil
+// if there is none.
+func (p *Progression) getLatestSuccessfulRelease(environmentID string) *ReleaseProgression {
+	var latest *ReleaseProgression
+	var latestCompletedTime time.Time
+
+	for _, releaseProgression := range p.Releases {
+		if releaseProgression == nil || releaseProgression.Release == nil {
+			continue
+		}
+
+		for _, item := range releaseProgression.Deployments[environmentID] {
+			if item.State != string(TaskStateSuccess) {
+				continue
+			}
+
+			var completedTime time.Time
+			if item.CompletedTime != nil {
+				completedTime = *item.CompletedTime
+			}
+
+			if latest == nil || completedTime.After(latestCompletedTime) {
+				latest = releaseProgression
+				latestCompletedTime = completedTime
+			}
+		}
+	}
+
+	return latest
+}
+
+// Promote deploys the release of the project that was most recently
+// deployed successfully to the source environment to the target environment.
+// The lifecycle of the release's channel, or of the project if the channel
+// has none, must allow the promotion; if not, a *PromotionBlockedError is
+// returned, which matches ErrPromotionBlocked. Successful deployments to any
+// tenant count towards the completion of a phase. The deployment is created
+// and waited on as by DeployRelease.
+func (c *Client) Promote(projectID string, fromEnvironmentID string, toEnvironmentID string, options DeployReleaseOptions) (*DeployReleaseResult, error) {
+	if isEmpty(projectID) {
+		return nil, createInvalidParameterError(OperationPromote, ParameterProjectID)
+	}
+
+	if isEmpty(fromEnvironmentID) {
+		return nil, createInvalidParameterError(OperationPromote, ParameterFromEnvironmentID)
+	}
+
+	if isEmpty(toEnvironmentID) {
+		return nil, createInvalidParameterError(OperationPromote, ParameterToEnvironmentID)
+	}
+
+	project, err := c.Projects.GetByID(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	progression, err := c.Projects.GetProgression(project)
+	if err != nil {
+		return nil, err
+	}
+
+	releaseProgression := progression.getLatestSuccessfulRelease(fromEnvironmentID)
+	if releaseProgression == nil {
+		return nil, fmt.Errorf("%s: no release of the project (%s) has been deployed successfully to the environment (%s)", OperationPromote, project.Name, fromEnvironmentID)
+	}
+
+	channel := releaseProgression.Channel
+	if channel == nil {
+		channel, err = c.Channels.GetByID(releaseProgression.Release.ChannelID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	lifecycleID := channel.LifecycleID
+	if isEmpty(lifecycleID) {
+		lifecycleID = project.LifecycleID
+	}
+
+	lifecycle, err := c.Lifecycles.GetByID(lifecycleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := lifecycle.CheckPromotion(releaseProgression.getSuccessfulEnvironmentIDs(), toEnvironmentID); err != nil {
+		return nil, err
+	}
+
+	return c.DeployRelease(releaseProgression.Release.GetID(), []string{toEnvironmentID}, options)
+}
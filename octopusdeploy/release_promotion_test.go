@@ -0,0 +1,117 @@
+package octopusdeploy
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLifecycle() *Lifecycle {
+	lifecycle := NewLifecycle("Default")
+	lifecycle.Phases = []Phase{
+		{Name: "Development", AutomaticDeploymentTargets: []string{"Environments-1"}},
+		{Name: "Test", OptionalDeploymentTargets: []string{"Environments-3", "Environments-4"}, MinimumEnvironmentsBeforePromotion: 1},
+		{Name: "Hotfix", OptionalDeploymentTargets: []string{"Environments-5"}, IsOptionalPhase: true},
+		{Name: "Production", OptionalDeploymentTargets: []string{"Environments-2"}},
+	}
+	return lifecycle
+}
+
+func TestLifecycleCheckPromotion(t *testing.T) {
+	lifecycle := newTestLifecycle()
+
+	require.NoError(t, lifecycle.CheckPromotion(nil, "Environments-1"))
+	require.NoError(t, lifecycle.CheckPromotion([]string{"Environments-1"}, "Environments-4"))
+
+	// only one environment of the test phase is required and the hotfix
+	// phase is optional
+	require.NoError(t, lifecycle.CheckPromotion([]string{"Environments-1", "Environments-4"}, "Environments-2"))
+
+	err := lifecycle.CheckPromotion([]string{"Environments-1"}, "Environments-2")
+	require.True(t, errors.Is(err, ErrPromotionBlocked))
+	require.Equal(t, &PromotionBlockedError{
+		BlockingPhase:         "Test",
+		CompletedEnvironments: 0,
+		EnvironmentID:         "Environments-2",
+		LifecycleName:         "Default",
+		Reason:                PromotionBlockedReasonPhaseIncomplete,
+		RequiredEnvironments:  1,
+	}, err)
+	require.EqualError(t, err, "the promotion is not allowed by the lifecycle: the phase Test of the lifecycle (Default) must be complete before deploying to the environment (Environments-2); 0 of 1 required environments have a successful deployment")
+
+	err = lifecycle.CheckPromotion(nil, "Environments-3")
+	require.Equal(t, "Development", err.(*PromotionBlockedError).BlockingPhase)
+
+	err = lifecycle.CheckPromotion([]string{"Environments-1"}, "Environments-9")
+	require.True(t, errors.Is(err, ErrPromotionBlocked))
+	require.Equal(t, PromotionBlockedReasonEnvironmentNotInLifecycle, err.(*PromotionBlockedError).Reason)
+	require.EqualError(t, err, "the promotion is not allowed by the lifecycle: the environment (Environments-9) is not in any phase of the lifecycle (Default)")
+
+	require.NoError(t, NewLifecycle("Empty").CheckPromotion(nil, "Environments-9"))
+}
+
+func TestPromote(t *testing.T) {
+	defer setTaskPollInterval(time.Millisecond)()
+
+	progression := `{"Releases":[
+		{"Release":{"Id":"Releases-1","ChannelId":"Channels-1","Version":"1.2.0"},"Deployments":{
+			"Environments-1":[{"State":"Success","CompletedTime":"2020-10-02T10:00:00Z"}],
+			"Environments-3":[{"State":"Failed","CompletedTime":"2020-10-02T11:00:00Z"}]}},
+		{"Release":{"Id":"Releases-0","ChannelId":"Channels-1","Version":"1.1.0"},"Deployments":{
+			"Environments-1":[{"State":"Success","CompletedTime":"2020-10-01T10:00:00Z"}],
+			"Environments-4":[{"State":"Success","CompletedTime":"2020-10-01T11:00:00Z"}]}}
+	]}`
+
+	deploymentServer := newFakeDeploymentServer(t)
+	base := createFakeSling(func(r *http.Request) (int, string) {
+		switch r.URL.Path {
+		case "/api/Spaces-1/projects/Projects-1":
+			return http.StatusOK, `{"Id":"Projects-1","Name":"Web","LifecycleId":"Lifecycles-1","Links":{"Progression":"/api/Spaces-1/progression/Projects-1{?aggregate}"}}`
+		case "/api/Spaces-1/progression/Projects-1":
+			return http.StatusOK, progression
+		case "/api/Spaces-1/channels/Channels-1":
+			return http.StatusOK, `{"Id":"Channels-1","ProjectId":"Projects-1"}`
+		case "/api/Spaces-1/lifecycles/Lifecycles-1":
+			return http.StatusOK, `{"Id":"Lifecycles-1","Name":"Default","Phases":[
+				{"Name":"Development","AutomaticDeploymentTargets":["Environments-1"]},
+				{"Name":"Test","OptionalDeploymentTargets":["Environments-3","Environments-4"],"MinimumEnvironmentsBeforePromotion":1},
+				{"Name":"Production","OptionalDeploymentTargets":["Environments-2"]}]}`
+		case "/api/Spaces-1/releases/Releases-0":
+			return http.StatusOK, `{"Id":"Releases-0","ChannelId":"Channels-1","ProjectId":"Projects-1","Version":"1.1.0"}`
+		}
+		return deploymentServer.handle(r)
+	})
+
+	client := &Client{
+		Channels:     newChannelService(base, TestURIChannels, emptyString),
+		Deployments:  newDeploymentService(base, TestURIDeployments),
+		Environments: newEnvironmentService(base, TestURIEnvironments, emptyString, emptyString),
+		Lifecycles:   newLifecycleService(base, TestURILifecycles),
+		Projects:     newProjectService(base, TestURIProjects, emptyString, emptyString),
+		Releases:     newReleaseService(base, TestURIReleases),
+		Tasks:        newTaskService(base, TestURITasks, TestURITaskTypes),
+	}
+
+	// the most recent release in development has not completed the test
+	// phase
+	_, err := client.Promote("Projects-1", "Environments-1", "Environments-2", DeployReleaseOptions{})
+	require.True(t, errors.Is(err, ErrPromotionBlocked))
+	require.Equal(t, "Test", err.(*PromotionBlockedError).BlockingPhase)
+	require.Empty(t, deploymentServer.deployments)
+
+	// the release in test has
+	result, err := client.Promote("Projects-1", "Environments-4", "Environments-2", DeployReleaseOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "Releases-0", result.ReleaseID)
+	require.Len(t, deploymentServer.deployments, 1)
+	require.Equal(t, "Environments-2", *deploymentServer.deployments[0].EnvironmentID)
+
+	_, err = client.Promote("Projects-1", "Environments-2", "Environments-1", DeployReleaseOptions{})
+	require.EqualError(t, err, "Promote: no release of the project (Web) has been deployed successfully to the environment (Environments-2)")
+
+	_, err = client.Promote("Projects-1", emptyString, "Environments-2", DeployReleaseOptions{})
+	require.Equal(t, createInvalidParameterError(OperationPromote, ParameterFromEnvironmentID), err)
+}
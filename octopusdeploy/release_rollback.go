@@ -0,0 +1,160 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// RollbackOptions configures Rollback.
+type RollbackOptions struct {
+	Comments string
+
+	// ReuseFormValues submits the form values of the deployment that is
+	// rolled back to.
+	ReuseFormValues bool
+
+	// ReuseSkipActions skips the steps that were skipped by the deployment
+	// that is rolled back to.
+	ReuseSkipActions bool
+
+	UseGuidedFailure bool
+}
+
+// RollbackResult describes a rollback: the release that was deployed most
+// recently, the earlier release that was redeployed, the deployment of the
+// earlier release that succeeded, and the new deployment, whose task can be
+// waited on with WaitForTask.
+type RollbackResult struct {
+	Deployment         *Deployment `json:"Deployment"`
+	FromRelease        *Release    `json:"FromRelease"`
+	PreviousDeployment *Deployment `json:"PreviousDeployment"`
+	ToRelease          *Release    `json:"ToRelease"`
+}
+
+// WriteJSON writes the result to the input writer as indented JSON.
+func (r *RollbackResult) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent(empty, tab)
+	return encoder.Encode(r)
+}
+
+// Rollback redeploys the last known-good release of the project to the
+// environment, and the tenant if its ID is not empty. The deployment history
+// is searched, newest first, for the most recent deployment, whatever its
+// state, and then for the most recent successful deployment of a different
+// release; that release is deployed again. The new deployment is not waited
+// on.
+func (c *Client) Rollback(projectID string, environmentID string, tenantID string, options RollbackOptions) (*RollbackResult, error) {
+	if isEmpty(projectID) {
+		return nil, createInvalidParameterError(OperationRollback, ParameterProjectID)
+	}
+
+	if isEmpty(environmentID) {
+		return nil, createInvalidParameterError(OperationRollback, ParameterEnvironmentID)
+	}
+
+	deploymentsQuery := DeploymentsQuery{
+		Environments: []string{environmentID},
+		Projects:     []string{projectID},
+	}
+	if !isEmpty(tenantID) {
+		deploymentsQuery.Tenants = []string{tenantID}
+	}
+
+	var current *Deployment
+	var previous *Deployment
+
+	// deployments are returned newest first
+	for previous == nil {
+		deployments, err := c.Deployments.Get(deploymentsQuery)
+		if err != nil {
+			return nil, err
+		}
+
+		taskIDs := []string{}
+		for _, deployment := range deployments.Items {
+			if !isEmpty(deployment.TaskID) {
+				taskIDs = append(taskIDs, deployment.TaskID)
+			}
+		}
+
+		taskStates := map[string]TaskState{}
+		if len(taskIDs) > 0 {
+			tasks, err := c.Tasks.GetAll(TasksQuery{IDs: taskIDs})
+			if err != nil {
+				return nil, err
+			}
+
+			for _, task := range tasks {
+				taskStates[task.GetID()] = task.State
+			}
+		}
+
+		for _, deployment := range deployments.Items {
+			if deployment.TenantID != tenantID || deployment.ReleaseID == nil {
+				continue
+			}
+
+			if current == nil {
+				current = deployment
+				continue
+			}
+
+			if *deployment.ReleaseID != *current.ReleaseID && taskStates[deployment.TaskID] == TaskStateSuccess {
+				previous = deployment
+				break
+			}
+		}
+
+		deploymentsQuery.Skip += len(deployments.Items)
+		if len(deployments.Items) == 0 || deploymentsQuery.Skip >= deployments.TotalResults {
+			break
+		}
+	}
+
+	if current == nil {
+		return nil, fmt.Errorf("%s: the project (%s) has not been deployed to the environment (%s)", OperationRollback, projectID, environmentID)
+	}
+
+	if previous == nil {
+		return nil, fmt.Errorf("%s: no release of the project (%s) other than the current one has been deployed successfully to the environment (%s)", OperationRollback, projectID, environmentID)
+	}
+
+	fromRelease, err := c.Releases.GetByID(*current.ReleaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	toRelease, err := c.Releases.GetByID(*previous.ReleaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment := NewDeployment(emptyString, environmentID, toRelease.GetID())
+	deployment.ChannelID = toRelease.ChannelID
+	deployment.Comments = options.Comments
+	deployment.ProjectID = projectID
+	deployment.TenantID = tenantID
+	deployment.UseGuidedFailure = options.UseGuidedFailure
+
+	if options.ReuseFormValues {
+		deployment.FormValues = previous.FormValues
+	}
+
+	if options.ReuseSkipActions {
+		deployment.SkipActions = previous.SkipActions
+	}
+
+	deployment, err = c.Deployments.Add(deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RollbackResult{
+		Deployment:         deployment,
+		FromRelease:        fromRelease,
+		PreviousDeployment: previous,
+		ToRelease:          toRelease,
+	}, nil
+}
@@ -0,0 +1,114 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollback(t *testing.T) {
+	var created *Deployment
+	var deploymentQueries []string
+
+	base := createFakeSling(func(r *http.Request) (int, string) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/Spaces-1/deployments":
+			deploymentQueries = append(deploymentQueries, r.URL.RawQuery)
+			if r.URL.Query().Get("skip") == "" {
+				return http.StatusOK, `{"TotalResults":5,"Items":[
+					{"Id":"Deployments-5","ReleaseId":"Releases-3","EnvironmentId":"Environments-2","TaskId":"ServerTasks-5"},
+					{"Id":"Deployments-4","ReleaseId":"Releases-2","EnvironmentId":"Environments-2","TaskId":"ServerTasks-4","TenantId":"Tenants-1"},
+					{"Id":"Deployments-3","ReleaseId":"Releases-3","EnvironmentId":"Environments-2","TaskId":"ServerTasks-3"}]}`
+			}
+			return http.StatusOK, `{"TotalResults":5,"Items":[
+				{"Id":"Deployments-2","ReleaseId":"Releases-2","EnvironmentId":"Environments-2","TaskId":"ServerTasks-2"},
+				{"Id":"Deployments-1","ReleaseId":"Releases-1","EnvironmentId":"Environments-2","TaskId":"ServerTasks-1","FormValues":{"Approver":"jo"},"SkipActions":["Actions-2"]}]}`
+		case r.URL.Path == "/api/tasks":
+			states := map[string]string{
+				"ServerTasks-1": "Success",
+				"ServerTasks-2": "Failed",
+				"ServerTasks-3": "Success",
+				"ServerTasks-4": "Success",
+				"ServerTasks-5": "Failed",
+			}
+			items := []string{}
+			for _, id := range r.URL.Query()["ids"] {
+				items = append(items, `{"Id":"`+id+`","State":"`+states[id]+`"}`)
+			}
+			return http.StatusOK, `{"Items":[` + strings.Join(items, ",") + `]}`
+		case strings.HasPrefix(r.URL.Path, "/api/Spaces-1/releases/"):
+			id := strings.TrimPrefix(r.URL.Path, "/api/Spaces-1/releases/")
+			return http.StatusOK, `{"Id":"` + id + `","ChannelId":"Channels-1","ProjectId":"Projects-1","Version":"1.0.` + strings.TrimPrefix(id, "Releases-") + `"}`
+		case r.Method == http.MethodPost && r.URL.Path == "/api/Spaces-1/deployments":
+			body, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			created = new(Deployment)
+			require.NoError(t, json.Unmarshal(body, created))
+			return http.StatusCreated, `{"Id":"Deployments-6","ReleaseId":"Releases-1","EnvironmentId":"Environments-2","TaskId":"ServerTasks-6"}`
+		}
+		return http.StatusNotFound, `{"ErrorMessage":"not found"}`
+	})
+
+	client := &Client{
+		Deployments: newDeploymentService(base, TestURIDeployments),
+		Releases:    newReleaseService(base, TestURIReleases),
+		Tasks:       newTaskService(base, TestURITasks, TestURITaskTypes),
+	}
+
+	result, err := client.Rollback("Projects-1", "Environments-2", emptyString, RollbackOptions{
+		Comments:        "Rolling back 1.0.3",
+		ReuseFormValues: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"environments=Environments-2&projects=Projects-1",
+		"environments=Environments-2&projects=Projects-1&skip=3",
+	}, deploymentQueries)
+
+	// the release of the latest deployment is skipped even though an
+	// earlier deployment of it succeeded, as is the tenanted deployment
+	require.Equal(t, "1.0.3", result.FromRelease.Version)
+	require.Equal(t, "1.0.1", result.ToRelease.Version)
+	require.Equal(t, "Deployments-1", result.PreviousDeployment.GetID())
+	require.Equal(t, "ServerTasks-6", result.Deployment.TaskID)
+
+	require.Equal(t, "Releases-1", *created.ReleaseID)
+	require.Equal(t, "Environments-2", *created.EnvironmentID)
+	require.Equal(t, "Rolling back 1.0.3", created.Comments)
+	require.Equal(t, map[string]string{"Approver": "jo"}, created.FormValues)
+	require.Empty(t, created.SkipActions)
+
+	_, err = client.Rollback("Projects-1", "Environments-2", "Tenants-1", RollbackOptions{})
+	require.EqualError(t, err, "Rollback: no release of the project (Projects-1) other than the current one has been deployed successfully to the environment (Environments-2)")
+
+	_, err = client.Rollback("Projects-1", emptyString, emptyString, RollbackOptions{})
+	require.Equal(t, createInvalidParameterError(OperationRollback, ParameterEnvironmentID), err)
+}
+
+func TestWaitForTask(t *testing.T) {
+	defer setTaskPollInterval(time.Millisecond)()
+
+	polls := 0
+	base := createFakeSling(func(r *http.Request) (int, string) {
+		polls++
+		if polls < 3 {
+			return http.StatusOK, `{"Id":"ServerTasks-1","State":"Executing"}`
+		}
+		return http.StatusOK, `{"Id":"ServerTasks-1","State":"Success","IsCompleted":true}`
+	})
+
+	client := &Client{Tasks: newTaskService(base, TestURITasks, TestURITaskTypes)}
+
+	task, err := client.WaitForTask("ServerTasks-1", time.Second)
+	require.NoError(t, err)
+	require.Equal(t, TaskStateSuccess, task.State)
+	require.Equal(t, 3, polls)
+
+	_, err = client.WaitForTask(emptyString, 0)
+	require.Equal(t, createInvalidParameterError(OperationWaitForTask, ParameterTaskID), err)
+}
@@ -1,6 +1,9 @@
 package octopusdeploy
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/fqjony/go-octopusdeploy/uritemplates"
 	"github.com/dghubble/sling"
 	"github.com/google/go-querystring/query"
@@ -36,11 +39,18 @@ func (s releaseService) Add(release *Release) (*Release, error) {
 // input query parameter. If an error occurs, an empty collection is returned
 // along with the associated error.
 func (s releaseService) Get(releasesQuery ...ReleasesQuery) (*Releases, error) {
-	v, _ := query.Values(releasesQuery[0])
+	err := validateInternalState(s)
+	if err != nil {
+		return &Releases{}, err
+	}
+
 	path := s.BasePath
-	encodedQueryString := v.Encode()
-	if len(encodedQueryString) > 0 {
-		path += "?" + encodedQueryString
+	if len(releasesQuery) > 0 {
+		v, _ := query.Values(releasesQuery[0])
+		encodedQueryString := v.Encode()
+		if len(encodedQueryString) > 0 {
+			path += "?" + encodedQueryString
+		}
 	}
 
 	resp, err := apiGet(s.getClient(), new(Releases), path)
@@ -67,6 +77,256 @@ func (s releaseService) GetByID(id string) (*Release, error) {
 	return resp.(*Release), nil
 }
 
+// GetByProjectAndVersion returns the release of the project that matches the
+// input version. If one cannot be found, it returns nil and a resource not
+// found error; other failures are returned as they are.
+func (s releaseService) GetByProjectAndVersion(project *Project, version string) (*Release, error) {
+	if project == nil {
+		return nil, createInvalidParameterError(OperationGetByProjectAndVersion, ParameterProject)
+	}
+
+	if isEmpty(version) {
+		return nil, createInvalidParameterError(OperationGetByProjectAndVersion, ParameterVersion)
+	}
+
+	err := validateInternalState(s)
+	if err != nil {
+		return nil, err
+	}
+
+	template := project.GetLinks()[linkReleases]
+	if isEmpty(template) {
+		return nil, createInvalidPathError(s.getName())
+	}
+
+	uriTemplate, err := uritemplates.Parse(template)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := uriTemplate.Expand(map[string]interface{}{"version": version})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiGet(s.getClient(), new(Release), path)
+	if err == ErrItemNotFound {
+		return nil, createResourceNotFoundError(s.getName(), "version", version)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*Release), nil
+}
+
+// GetLatestForChannel returns the release of the channel with the highest
+// version. Versions are compared as semantic versions rather than by the
+// order in which the releases were created. If the channel has no releases,
+// it returns nil and an error.
+func (s releaseService) GetLatestForChannel(channel *Channel) (*Release, error) {
+	if channel == nil {
+		return nil, createInvalidParameterError(OperationGetLatestForChannel, ParameterChannel)
+	}
+
+	err := validateInternalState(s)
+	if err != nil {
+		return nil, err
+	}
+
+	path := trimTemplate(channel.GetLinks()[linkReleases])
+	if isEmpty(path) {
+		return nil, createInvalidPathError(s.getName())
+	}
+
+	releases := []*Release{}
+	loadNextPage := true
+
+	for loadNextPage {
+		resp, err := apiGet(s.getClient(), new(Releases), path)
+		if err != nil {
+			return nil, err
+		}
+
+		responseList := resp.(*Releases)
+		releases = append(releases, responseList.Items...)
+		path, loadNextPage = LoadNextPage(responseList.PagedResults)
+	}
+
+	if len(releases) == 0 {
+		return nil, createResourceNotFoundError(s.getName(), "latest release of the channel", channel.GetID())
+	}
+
+	sort.SliceStable(releases, func(i, j int) bool {
+		return compareReleaseVersions(releases[i].Version, releases[j].Version) > 0
+	})
+
+	return releases[0], nil
+}
+
+// GetDeploymentTemplate returns the environments and tenants the release
+// can be deployed to, and whether the deployment process or variables of the
+// project have changed since the release was created.
+func (s releaseService) GetDeploymentTemplate(release *Release) (*DeploymentTemplate, error) {
+	if release == nil {
+		return nil, createInvalidParameterError(OperationGetDeploymentTemplate, ParameterRelease)
+	}
+
+	err := validateInternalState(s)
+	if err != nil {
+		return nil, err
+	}
+
+	path := trimTemplate(release.GetLinks()[linkDeploymentTemplate])
+	if isEmpty(path) {
+		return nil, createInvalidPathError(s.getName())
+	}
+
+	resp, err := apiGet(s.getClient(), new(DeploymentTemplate), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*DeploymentTemplate), nil
+}
+
+// GetDeploymentPreview returns the preview of a deployment of the release to
+// the environment, or to the tenant in the environment if the tenant is not
+// nil: the steps that will run, the machines they target and the form of
+// prompted variables. An error is returned if the release cannot be deployed
+// there.
+func (s releaseService) GetDeploymentPreview(release *Release, environment *Environment, tenant *Tenant) (*DeploymentPreview, error) {
+	if environment == nil {
+		return nil, createInvalidParameterError(OperationGetDeploymentPreview, ParameterEnvironment)
+	}
+
+	template, err := s.GetDeploymentTemplate(release)
+	if err != nil {
+		return nil, err
+	}
+
+	var target *DeploymentPromotionTarget
+	if tenant == nil {
+		target = template.getPromotionTarget(environment.GetID(), emptyString)
+		if target == nil {
+			return nil, fmt.Errorf("%s: the release (%s) cannot be deployed to the environment (%s)", OperationGetDeploymentPreview, release.Version, environment.Name)
+		}
+	} else {
+		target = template.getPromotionTarget(environment.GetID(), tenant.GetID())
+		if target == nil {
+			return nil, fmt.Errorf("%s: the release (%s) cannot be deployed to the tenant (%s) in the environment (%s)", OperationGetDeploymentPreview, release.Version, tenant.Name, environment.Name)
+		}
+	}
+
+	path := trimTemplate(target.GetLinks()[linkPreview])
+	if isEmpty(path) {
+		return nil, createInvalidPathError(s.getName())
+	}
+
+	resp, err := apiGet(s.getClient(), new(DeploymentPreview), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*DeploymentPreview), nil
+}
+
+// GetDeploymentsOfRelease returns the deployments of the release, newest
+// first. If environment or tenant IDs are given, only the deployments to
+// those environments or tenants are returned.
+func (s releaseService) GetDeploymentsOfRelease(release *Release, environmentIDs []string, tenantIDs []string) ([]*Deployment, error) {
+	if release == nil {
+		return nil, createInvalidParameterError(OperationGetDeploymentsOfRelease, ParameterRelease)
+	}
+
+	err := validateInternalState(s)
+	if err != nil {
+		return nil, err
+	}
+
+	path := trimTemplate(release.GetLinks()[linkDeployments])
+	if isEmpty(path) {
+		return nil, createInvalidPathError(s.getName())
+	}
+
+	deployments := []*Deployment{}
+	loadNextPage := true
+
+	for loadNextPage {
+		resp, err := apiGet(s.getClient(), new(Deployments), path)
+		if err != nil {
+			return nil, err
+		}
+
+		responseList := resp.(*Deployments)
+		for _, deployment := range responseList.Items {
+			if len(environmentIDs) > 0 && (deployment.EnvironmentID == nil || !ValidateStringInSlice(*deployment.EnvironmentID, environmentIDs)) {
+				continue
+			}
+
+			if len(tenantIDs) > 0 && !ValidateStringInSlice(deployment.TenantID, tenantIDs) {
+				continue
+			}
+
+			deployments = append(deployments, deployment)
+		}
+		path, loadNextPage = LoadNextPage(responseList.PagedResults)
+	}
+
+	return deployments, nil
+}
+
+// GetProgression returns the progression of the release through the phases
+// of its lifecycle.
+func (s releaseService) GetProgression(release *Release) (*LifecycleProgression, error) {
+	if release == nil {
+		return nil, createInvalidParameterError(OperationGetProgression, ParameterRelease)
+	}
+
+	err := validateInternalState(s)
+	if err != nil {
+		return nil, err
+	}
+
+	path := trimTemplate(release.GetLinks()[linkProgression])
+	if isEmpty(path) {
+		return nil, createInvalidPathError(s.getName())
+	}
+
+	resp, err := apiGet(s.getClient(), new(LifecycleProgression), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*LifecycleProgression), nil
+}
+
+// UpdateVariables refreshes the snapshot of the project and library variable
+// sets taken by the release, so that later deployments of the release use
+// the current values of the variables, and returns the updated release.
+func (s releaseService) UpdateVariables(release *Release) (*Release, error) {
+	if release == nil {
+		return nil, createInvalidParameterError(OperationUpdateVariables, ParameterRelease)
+	}
+
+	err := validateInternalState(s)
+	if err != nil {
+		return nil, err
+	}
+
+	path := trimTemplate(release.GetLinks()[linkSnapshotVariables])
+	if isEmpty(path) {
+		return nil, createInvalidPathError(s.getName())
+	}
+
+	resp, err := apiPost(s.getClient(), nil, new(Release), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*Release), nil
+}
+
 func (s deploymentService) GetDeployments(release *Release, deploymentQuery ...*DeploymentQuery) (*Deployments, error) {
 	if release == nil {
 		return nil, createInvalidParameterError(OperationGetDeployments, ParameterRelease)
@@ -98,6 +358,12 @@ func (s deploymentService) GetDeployments(release *Release, deploymentQuery ...*
 	return resp.(*Deployments), nil
 }
 
+// GetProgression returns the progression of the release.
+//
+// Deprecated: the progression of a release is the progression through the
+// phases of its lifecycle, which Progression does not describe. Use the
+// GetProgression method of the release service, which returns a
+// LifecycleProgression.
 func (s deploymentService) GetProgression(release *Release) (*Progression, error) {
 	if release == nil {
 		return nil, createInvalidParameterError(OperationGetDeployments, ParameterRelease)
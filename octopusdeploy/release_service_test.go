@@ -0,0 +1,217 @@
+package octopusdeploy
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createFakeReleaseService() *releaseService {
+	base := createFakeSling(func(r *http.Request) (int, string) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/Spaces-1/releases":
+			return http.StatusOK, `{"Items":[{"Id":"Releases-1","Version":"1.0.0"}],"TotalResults":1}`
+		case r.Method == http.MethodGet && r.URL.Path == "/api/Spaces-1/projects/Projects-1/releases/1.0.0-beta+1":
+			return http.StatusOK, `{"Id":"Releases-1","ProjectId":"Projects-1","Version":"1.0.0-beta+1"}`
+		case r.Method == http.MethodGet && r.URL.Path == "/api/Spaces-1/projects/Projects-1/releases/3.0.0":
+			return http.StatusInternalServerError, `{"ErrorMessage":"database unavailable"}`
+		case r.Method == http.MethodGet && r.URL.Path == "/api/Spaces-1/channels/Channels-1/releases":
+			if r.URL.Query().Get("skip") == "2" {
+				return http.StatusOK, `{"Items":[{"Id":"Releases-1","Version":"1.0.9"}]}`
+			}
+			return http.StatusOK, `{"Items":[{"Id":"Releases-4","Version":"nightly"},{"Id":"Releases-3","Version":"1.0.0-rc.1"},{"Id":"Releases-2","Version":"1.0.10"}],
+				"Links":{"Page.Next":"/api/Spaces-1/channels/Channels-1/releases?skip=2"}}`
+		case r.Method == http.MethodGet && r.URL.Path == "/api/Spaces-1/channels/Channels-2/releases":
+			return http.StatusOK, `{"Items":[]}`
+		case r.Method == http.MethodGet && r.URL.Path == "/api/Spaces-1/releases/Releases-1/deployments":
+			return http.StatusOK, `{"Items":[
+				{"Id":"Deployments-3","EnvironmentId":"Environments-2","TenantId":"Tenants-1"},
+				{"Id":"Deployments-2","EnvironmentId":"Environments-2"},
+				{"Id":"Deployments-1","EnvironmentId":"Environments-1","TenantId":"Tenants-1"}]}`
+		case r.Method == http.MethodGet && r.URL.Path == "/api/Spaces-1/releases/Releases-1/progression":
+			return http.StatusOK, `{"NextDeployments":["Environments-2"],"Phases":[{"Id":"Phases-1","Name":"Development","Progress":"Complete","Deployments":[{"EnvironmentId":"Environments-1","State":"Success"}]}]}`
+		case r.Method == http.MethodGet && r.URL.Path == "/api/Spaces-1/releases/Releases-1/deployments/template":
+			return http.StatusOK, `{"IsVariableSetModified":true,
+				"PromoteTo":[{"Id":"Environments-1","Name":"Development","Links":{"Preview":"/api/Spaces-1/releases/Releases-1/deployments/preview/Environments-1{?includeDisabledSteps}"}}],
+				"TenantPromotions":[{"Id":"Tenants-1","Name":"Contoso","PromoteTo":[{"Id":"Environments-2","Name":"Production","Links":{"Preview":"/api/Spaces-1/releases/Releases-1/deployments/preview/Environments-2/Tenants-1{?includeDisabledSteps}"}}]}]}`
+		case r.Method == http.MethodGet && r.URL.Path == "/api/Spaces-1/releases/Releases-1/deployments/preview/Environments-1":
+			return http.StatusOK, `{"StepsToExclude":[{"ActionId":"Actions-1","ActionName":"Deploy web","ActionNumber":"1","CanBeSkipped":true,"MachineNames":["web-01","web-02"],"Roles":["web"]}],
+				"Form":{"Elements":[{"Name":"6d5d1b6a","Control":{"Type":"VariableValue","Name":"Approver","Label":"Approver"},"IsValueRequired":true}],"Values":{}}}`
+		case r.Method == http.MethodGet && r.URL.Path == "/api/Spaces-1/releases/Releases-1/deployments/preview/Environments-2/Tenants-1":
+			return http.StatusOK, `{"StepsToExclude":[{"ActionId":"Actions-1","ActionName":"Deploy web","ActionNumber":"1","MachineNames":["contoso-web"]}]}`
+		case r.Method == http.MethodPost && r.URL.Path == "/api/Spaces-1/releases/Releases-1/snapshot-variables":
+			return http.StatusOK, `{"Id":"Releases-1","ProjectVariableSetSnapshotId":"variableset-Projects-1-s-2"}`
+		}
+		return http.StatusNotFound, `{"ErrorMessage":"not found"}`
+	})
+
+	return newReleaseService(base, TestURIReleases)
+}
+
+func newTestReleaseWithLinks() *Release {
+	release := NewRelease("Channels-1", "Projects-1", "1.0.0")
+	release.ID = "Releases-1"
+	release.Links = map[string]string{
+		linkDeployments:        "/api/Spaces-1/releases/Releases-1/deployments{?skip,take}",
+		linkDeploymentTemplate: "/api/Spaces-1/releases/Releases-1/deployments/template",
+		linkProgression:        "/api/Spaces-1/releases/Releases-1/progression",
+		linkSnapshotVariables:  "/api/Spaces-1/releases/Releases-1/snapshot-variables",
+	}
+	return release
+}
+
+func TestReleaseServiceGetWithoutQuery(t *testing.T) {
+	service := createFakeReleaseService()
+
+	releases, err := service.Get()
+	require.NoError(t, err)
+	require.Len(t, releases.Items, 1)
+}
+
+func TestReleaseServiceGetByProjectAndVersion(t *testing.T) {
+	service := createFakeReleaseService()
+	project := NewProject("Web", "Lifecycles-1", "ProjectGroups-1")
+	project.Links = map[string]string{
+		linkReleases: "/api/Spaces-1/projects/Projects-1/releases{/version}{?skip,take,searchByVersion}",
+	}
+
+	release, err := service.GetByProjectAndVersion(project, "1.0.0-beta+1")
+	require.NoError(t, err)
+	require.Equal(t, "Releases-1", release.GetID())
+
+	release, err = service.GetByProjectAndVersion(project, "2.0.0")
+	require.Equal(t, createResourceNotFoundError(service.getName(), "version", "2.0.0"), err)
+	require.Nil(t, release)
+
+	// failures other than a missing release are not reported as not found
+	release, err = service.GetByProjectAndVersion(project, "3.0.0")
+	require.Error(t, err)
+	require.NotEqual(t, createResourceNotFoundError(service.getName(), "version", "3.0.0"), err)
+	require.Contains(t, err.Error(), "database unavailable")
+	require.Nil(t, release)
+
+	_, err = service.GetByProjectAndVersion(nil, "1.0.0")
+	require.Equal(t, createInvalidParameterError(OperationGetByProjectAndVersion, ParameterProject), err)
+
+	_, err = service.GetByProjectAndVersion(project, emptyString)
+	require.Equal(t, createInvalidParameterError(OperationGetByProjectAndVersion, ParameterVersion), err)
+
+	_, err = service.GetByProjectAndVersion(NewProject("Web", "Lifecycles-1", "ProjectGroups-1"), "1.0.0")
+	require.Equal(t, createInvalidPathError(service.getName()), err)
+}
+
+func TestReleaseServiceGetLatestForChannel(t *testing.T) {
+	service := createFakeReleaseService()
+	channel := NewChannel("Default", emptyString, "Projects-1")
+	channel.Links = map[string]string{
+		linkReleases: "/api/Spaces-1/channels/Channels-1/releases{?skip,take,searchByVersion}",
+	}
+
+	release, err := service.GetLatestForChannel(channel)
+	require.NoError(t, err)
+	require.Equal(t, "1.0.10", release.Version)
+
+	channel.Links[linkReleases] = "/api/Spaces-1/channels/Channels-2/releases{?skip,take,searchByVersion}"
+	release, err = service.GetLatestForChannel(channel)
+	require.Error(t, err)
+	require.Nil(t, release)
+
+	_, err = service.GetLatestForChannel(nil)
+	require.Equal(t, createInvalidParameterError(OperationGetLatestForChannel, ParameterChannel), err)
+}
+
+func TestReleaseServiceGetDeploymentsOfRelease(t *testing.T) {
+	service := createFakeReleaseService()
+	release := newTestReleaseWithLinks()
+
+	deployments, err := service.GetDeploymentsOfRelease(release, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, deployments, 3)
+
+	deployments, err = service.GetDeploymentsOfRelease(release, []string{"Environments-2"}, nil)
+	require.NoError(t, err)
+	require.Len(t, deployments, 2)
+
+	deployments, err = service.GetDeploymentsOfRelease(release, []string{"Environments-2"}, []string{"Tenants-1"})
+	require.NoError(t, err)
+	require.Len(t, deployments, 1)
+	require.Equal(t, "Deployments-3", deployments[0].GetID())
+
+	_, err = service.GetDeploymentsOfRelease(nil, nil, nil)
+	require.Equal(t, createInvalidParameterError(OperationGetDeploymentsOfRelease, ParameterRelease), err)
+}
+
+func TestReleaseServiceGetProgression(t *testing.T) {
+	service := createFakeReleaseService()
+
+	progression, err := service.GetProgression(newTestReleaseWithLinks())
+	require.NoError(t, err)
+	require.Equal(t, []string{"Environments-2"}, progression.NextDeployments)
+	require.Len(t, progression.Phases, 1)
+	require.Equal(t, PhaseProgressComplete, progression.Phases[0].Progress)
+	require.Equal(t, "Environments-1", progression.Phases[0].Deployments[0].EnvironmentID)
+}
+
+func TestReleaseServiceUpdateVariables(t *testing.T) {
+	service := createFakeReleaseService()
+
+	release, err := service.UpdateVariables(newTestReleaseWithLinks())
+	require.NoError(t, err)
+	require.Equal(t, "variableset-Projects-1-s-2", release.ProjectVariableSetSnapshotID)
+
+	_, err = service.UpdateVariables(NewRelease("Channels-1", "Projects-1", "1.0.0"))
+	require.Equal(t, createInvalidPathError(ServiceReleaseService), err)
+}
+
+func TestReleaseServiceGetDeploymentTemplate(t *testing.T) {
+	service := createFakeReleaseService()
+
+	template, err := service.GetDeploymentTemplate(newTestReleaseWithLinks())
+	require.NoError(t, err)
+	require.True(t, template.IsVariableSetModified)
+	require.Len(t, template.PromoteTo, 1)
+	require.Equal(t, "Development", template.PromoteTo[0].Name)
+	require.Len(t, template.TenantPromotions, 1)
+	require.Equal(t, "Environments-2", template.TenantPromotions[0].PromoteTo[0].GetID())
+
+	_, err = service.GetDeploymentTemplate(nil)
+	require.Equal(t, createInvalidParameterError(OperationGetDeploymentTemplate, ParameterRelease), err)
+}
+
+func TestReleaseServiceGetDeploymentPreview(t *testing.T) {
+	service := createFakeReleaseService()
+	release := newTestReleaseWithLinks()
+
+	development := NewEnvironment("Development")
+	development.ID = "Environments-1"
+	production := NewEnvironment("Production")
+	production.ID = "Environments-2"
+	tenant := NewTenant("Contoso", emptyString)
+	tenant.ID = "Tenants-1"
+
+	preview, err := service.GetDeploymentPreview(release, development, nil)
+	require.NoError(t, err)
+	require.Len(t, preview.StepsToExclude, 1)
+	require.Equal(t, []string{"web-01", "web-02"}, preview.StepsToExclude[0].MachineNames)
+	require.True(t, preview.StepsToExclude[0].CanBeSkipped)
+
+	deployment := NewDeployment(emptyString, development.GetID(), release.GetID())
+	require.EqualError(t, deployment.ValidateFormValues(preview.Form), "a value is required for Approver")
+	deployment.FormValues = map[string]string{"6d5d1b6a": "jo"}
+	require.NoError(t, deployment.ValidateFormValues(preview.Form))
+
+	preview, err = service.GetDeploymentPreview(release, production, tenant)
+	require.NoError(t, err)
+	require.Equal(t, []string{"contoso-web"}, preview.StepsToExclude[0].MachineNames)
+	require.Nil(t, preview.Form)
+
+	_, err = service.GetDeploymentPreview(release, production, nil)
+	require.EqualError(t, err, "GetDeploymentPreview: the release (1.0.0) cannot be deployed to the environment (Production)")
+
+	_, err = service.GetDeploymentPreview(release, development, tenant)
+	require.EqualError(t, err, "GetDeploymentPreview: the release (1.0.0) cannot be deployed to the tenant (Contoso) in the environment (Development)")
+
+	_, err = service.GetDeploymentPreview(release, nil, nil)
+	require.Equal(t, createInvalidParameterError(OperationGetDeploymentPreview, ParameterEnvironment), err)
+}
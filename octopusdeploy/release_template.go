@@ -0,0 +1,26 @@
+package octopusdeploy
+
+// ReleaseTemplate describes what is needed to create a release of a project
+// in a channel: the next version and the packages of the deployment process.
+type ReleaseTemplate struct {
+	DeploymentProcessID            string                    `json:"DeploymentProcessId,omitempty"`
+	LastReleaseVersion             string                    `json:"LastReleaseVersion,omitempty"`
+	NextVersionIncrement           string                    `json:"NextVersionIncrement,omitempty"`
+	Packages                       []*ReleaseTemplatePackage `json:"Packages"`
+	VersioningPackageReferenceName string                    `json:"VersioningPackageReferenceName,omitempty"`
+	VersioningPackageStepName      string                    `json:"VersioningPackageStepName,omitempty"`
+}
+
+// ReleaseTemplatePackage is a package referenced by a step of the deployment
+// process.
+type ReleaseTemplatePackage struct {
+	ActionName                 string `json:"ActionName,omitempty"`
+	FeedID                     string `json:"FeedId,omitempty"`
+	FeedName                   string `json:"FeedName,omitempty"`
+	IsResolvable               bool   `json:"IsResolvable"`
+	PackageID                  string `json:"PackageId,omitempty"`
+	PackageReferenceName       string `json:"PackageReferenceName,omitempty"`
+	ProjectName                string `json:"ProjectName,omitempty"`
+	StepName                   string `json:"StepName,omitempty"`
+	VersionSelectedLastRelease string `json:"VersionSelectedLastRelease,omitempty"`
+}
@@ -0,0 +1,84 @@
+package octopusdeploy
+
+import (
+	"strconv"
+	"strings"
+)
+
+// compareReleaseVersions returns -1, 0 or 1 if release version a is lower
+// than, equal to or higher than release version b. Versions are compared as
+// semantic versions, with up to four numeric components; build metadata is
+// ignored. A version that cannot be parsed is lower than any version that
+// can, and two such versions are compared as strings.
+func compareReleaseVersions(a string, b string) int {
+	left, leftErr := ParseServerVersion(a)
+	right, rightErr := ParseServerVersion(b)
+
+	switch {
+	case leftErr != nil && rightErr != nil:
+		return strings.Compare(a, b)
+	case leftErr != nil:
+		return -1
+	case rightErr != nil:
+		return 1
+	}
+
+	leftRelease := *left
+	leftRelease.PreRelease = empty
+	rightRelease := *right
+	rightRelease.PreRelease = empty
+	if result := leftRelease.Compare(&rightRelease); result != 0 {
+		return result
+	}
+
+	return comparePreReleases(left.PreRelease, right.PreRelease)
+}
+
+// comparePreReleases compares pre-release tags as semantic versions do: a
+// version without a tag is higher than one with a tag, and tags are compared
+// identifier by identifier, numerically where both are numeric.
+func comparePreReleases(a string, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case isEmpty(a):
+		return 1
+	case isEmpty(b):
+		return -1
+	}
+
+	left := strings.Split(a, ".")
+	right := strings.Split(b, ".")
+
+	for i := 0; i < len(left) && i < len(right); i++ {
+		leftNumber, leftErr := strconv.Atoi(left[i])
+		rightNumber, rightErr := strconv.Atoi(right[i])
+
+		switch {
+		case leftErr == nil && rightErr == nil:
+			if leftNumber != rightNumber {
+				if leftNumber < rightNumber {
+					return -1
+				}
+				return 1
+			}
+		case leftErr == nil:
+			return -1
+		case rightErr == nil:
+			return 1
+		default:
+			if result := strings.Compare(left[i], right[i]); result != 0 {
+				return result
+			}
+		}
+	}
+
+	switch {
+	case len(left) < len(right):
+		return -1
+	case len(left) > len(right):
+		return 1
+	}
+
+	return 0
+}
@@ -1,9 +1,17 @@
 package octopusdeploy
 
-import "github.com/dghubble/sling"
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/dghubble/sling"
+	"github.com/google/go-querystring/query"
+)
 
 type reportingService struct {
-	deploymentsCountedByWeekPath string
+	deploymentsCountedByWeekPath             string
+	deploymentsCountedByWeekUnsupportedError error
 
 	service
 }
@@ -15,3 +23,81 @@ func newReportingService(sling *sling.Sling, uriTemplate string, deploymentsCoun
 		service: newService(ServiceReportingService, sling, uriTemplate),
 	}
 }
+
+// deploymentHistoryResponseDecoder decodes successful responses as the XML
+// deployment history report and failed responses as JSON API errors.
+type deploymentHistoryResponseDecoder struct{}
+
+func (d deploymentHistoryResponseDecoder) Decode(resp *http.Response, v interface{}) error {
+	if history, ok := v.(*DeploymentHistory); ok {
+		parsed, err := ParseDeploymentHistory(resp.Body)
+		if err != nil {
+			return err
+		}
+		*history = *parsed
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// getParentPath returns the path of a link without its last segment, or an
+// empty string if the link has no parent path.
+func getParentPath(link string) string {
+	path := trimTemplate(link)
+	index := strings.LastIndex(path, "/")
+	if index <= 0 {
+		return emptyString
+	}
+	return path[:index]
+}
+
+// GetDeploymentsCountedByWeek returns the number of deployments completed in
+// each week that match the input query. If an error occurs, it returns nil
+// along with the associated error.
+func (s reportingService) GetDeploymentsCountedByWeek(deploymentsCountedByWeekQuery DeploymentsCountedByWeekQuery) (*DeploymentsCountedByWeek, error) {
+	if isEmpty(s.deploymentsCountedByWeekPath) {
+		return nil, s.getPathError(s.deploymentsCountedByWeekUnsupportedError)
+	}
+
+	v, _ := query.Values(deploymentsCountedByWeekQuery)
+	path := trimTemplate(s.deploymentsCountedByWeekPath)
+	encodedQueryString := v.Encode()
+	if len(encodedQueryString) > 0 {
+		path += "?" + encodedQueryString
+	}
+
+	resp, err := apiGet(s.getClient(), new(DeploymentsCountedByWeek), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*DeploymentsCountedByWeek), nil
+}
+
+// GetDeploymentHistory returns the XML deployment history report decoded
+// into a DeploymentHistory. The input query is sent to the server and also
+// applied to the decoded report, so the result only contains matching
+// deployments. If an error occurs, it returns nil along with the associated
+// error.
+func (s reportingService) GetDeploymentHistory(deploymentHistoryQuery DeploymentHistoryQuery) (*DeploymentHistory, error) {
+	path, err := getPath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	v, _ := query.Values(deploymentHistoryQuery)
+	path += "/deployments/xml"
+	encodedQueryString := v.Encode()
+	if len(encodedQueryString) > 0 {
+		path += "?" + encodedQueryString
+	}
+
+	client := s.getClient().New().ResponseDecoder(deploymentHistoryResponseDecoder{})
+	resp, err := apiGet(client, new(DeploymentHistory), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*DeploymentHistory).Filter(deploymentHistoryQuery), nil
+}
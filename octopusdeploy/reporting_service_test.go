@@ -0,0 +1,201 @@
+package octopusdeploy
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/stretchr/testify/require"
+)
+
+const testDeploymentHistoryResponse = `<?xml version="1.0" encoding="utf-8"?>
+<Deployments>
+	<Deployment>
+		<DeploymentId>Deployments-1</DeploymentId>
+		<DeploymentName>Deploy to Production</DeploymentName>
+		<ProjectId>Projects-1</ProjectId>
+		<ProjectName>Web</ProjectName>
+		<ProjectSlug>web</ProjectSlug>
+		<TenantId></TenantId>
+		<TenantName></TenantName>
+		<ReleaseId>Releases-1</ReleaseId>
+		<ReleaseVersion>1.0.0</ReleaseVersion>
+		<EnvironmentId>Environments-2</EnvironmentId>
+		<EnvironmentName>Production</EnvironmentName>
+		<ChannelId>Channels-1</ChannelId>
+		<ChannelName>Default</ChannelName>
+		<Created>2020-06-01T09:58:00Z</Created>
+		<QueueTime>2020-06-01T09:58:00Z</QueueTime>
+		<StartTime>2020-06-01T09:59:00Z</StartTime>
+		<CompletedTime>2020-06-01T10:00:00Z</CompletedTime>
+		<DurationSeconds>60</DurationSeconds>
+		<DeployedBy>jsmith</DeployedBy>
+		<TaskId>ServerTasks-1</TaskId>
+		<TaskState>Success</TaskState>
+	</Deployment>
+	<Deployment>
+		<DeploymentId>Deployments-2</DeploymentId>
+		<ProjectId>Projects-1</ProjectId>
+		<ProjectName>Web</ProjectName>
+		<EnvironmentId>Environments-1</EnvironmentId>
+		<EnvironmentName>Staging</EnvironmentName>
+		<CompletedTime>2020-06-07T23:00:00+00:00</CompletedTime>
+		<DurationSeconds>12.5</DurationSeconds>
+		<TaskState>Failed</TaskState>
+	</Deployment>
+	<Deployment>
+		<DeploymentId>Deployments-3</DeploymentId>
+		<ProjectId>Projects-2</ProjectId>
+		<ProjectName>Api</ProjectName>
+		<EnvironmentId>Environments-2</EnvironmentId>
+		<TenantId>Tenants-1</TenantId>
+		<TenantName>Acme</TenantName>
+		<CompletedTime>2020-06-08T08:00:00Z</CompletedTime>
+		<TaskState>Success</TaskState>
+	</Deployment>
+	<Deployment>
+		<DeploymentId>Deployments-4</DeploymentId>
+		<ProjectId>Projects-2</ProjectId>
+		<EnvironmentId>Environments-1</EnvironmentId>
+		<CompletedTime></CompletedTime>
+		<TaskState>Executing</TaskState>
+	</Deployment>
+</Deployments>`
+
+func TestReportingServiceNew(t *testing.T) {
+	ServiceFunction := newReportingService
+	client := &sling.Sling{}
+	uriTemplate := emptyString
+	deploymentsCountedByWeekPath := emptyString
+	ServiceName := ServiceReportingService
+
+	testCases := []struct {
+		name                         string
+		f                            func(*sling.Sling, string, string) *reportingService
+		client                       *sling.Sling
+		uriTemplate                  string
+		deploymentsCountedByWeekPath string
+	}{
+		{"NilClient", ServiceFunction, nil, uriTemplate, deploymentsCountedByWeekPath},
+		{"EmptyURITemplate", ServiceFunction, client, emptyString, deploymentsCountedByWeekPath},
+		{"URITemplateWithWhitespace", ServiceFunction, client, whitespaceString, deploymentsCountedByWeekPath},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := tc.f(tc.client, tc.uriTemplate, tc.deploymentsCountedByWeekPath)
+			testNewService(t, service, uriTemplate, ServiceName)
+		})
+	}
+}
+
+func TestReportingServiceGetDeploymentsCountedByWeek(t *testing.T) {
+	client := createFakeSling(func(r *http.Request) (int, string) {
+		require.Equal(t, "/api/Spaces-1/reporting/deployments-counted-by-week", r.URL.Path)
+		require.Equal(t, []string{"Projects-1", "Projects-2"}, r.URL.Query()["projectIds"])
+		return http.StatusOK, `{"Items":[{"Count":3,"WeekStart":"2020-06-01T00:00:00Z"},{"Count":2,"WeekStart":"2020-06-08T00:00:00Z"}],"TotalCount":5}`
+	})
+	service := newReportingService(client, "/api/Spaces-1/reporting", TestURIReportingDeploymentsCountedByWeek)
+
+	deploymentsCountedByWeek, err := service.GetDeploymentsCountedByWeek(DeploymentsCountedByWeekQuery{ProjectIDs: []string{"Projects-1", "Projects-2"}})
+	require.NoError(t, err)
+	require.Len(t, deploymentsCountedByWeek.Items, 2)
+	require.Equal(t, 5, deploymentsCountedByWeek.GetTotal())
+
+	service = newReportingService(client, "/api/Spaces-1/reporting", emptyString)
+	deploymentsCountedByWeek, err = service.GetDeploymentsCountedByWeek(DeploymentsCountedByWeekQuery{})
+	require.Equal(t, createInvalidPathError(ServiceReportingService), err)
+	require.Nil(t, deploymentsCountedByWeek)
+}
+
+func TestGetParentPath(t *testing.T) {
+	require.Equal(t, "/api/Spaces-1/reporting", getParentPath(TestURIReportingDeploymentsCountedByWeek))
+	require.Equal(t, emptyString, getParentPath("reporting"))
+	require.Equal(t, emptyString, getParentPath("/reporting"))
+	require.Equal(t, emptyString, getParentPath(emptyString))
+}
+
+func TestReportingServiceGetDeploymentHistory(t *testing.T) {
+	client := createFakeSling(func(r *http.Request) (int, string) {
+		require.Equal(t, "/api/Spaces-1/reporting/deployments/xml", r.URL.Path)
+		return http.StatusOK, testDeploymentHistoryResponse
+	})
+	service := newReportingService(client, "/api/Spaces-1/reporting", TestURIReportingDeploymentsCountedByWeek)
+
+	history, err := service.GetDeploymentHistory(DeploymentHistoryQuery{})
+	require.NoError(t, err)
+	require.Len(t, history.Items, 4)
+
+	item := history.Items[0]
+	require.Equal(t, "Deployments-1", item.DeploymentID)
+	require.Equal(t, "Production", item.EnvironmentName)
+	require.Equal(t, 60, item.DurationSeconds)
+	require.Equal(t, TaskStateSuccess, item.TaskState)
+	require.Equal(t, time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC), item.CompletedTime.UTC())
+	require.Equal(t, 12, history.Items[1].DurationSeconds)
+	require.Nil(t, history.Items[3].CompletedTime)
+
+	history, err = service.GetDeploymentHistory(DeploymentHistoryQuery{EnvironmentIDs: []string{"Environments-2"}})
+	require.NoError(t, err)
+	require.Len(t, history.Items, 2)
+
+	client = createFakeSling(func(r *http.Request) (int, string) {
+		return http.StatusBadRequest, `{"ErrorMessage":"bad request"}`
+	})
+	service = newReportingService(client, "/api/Spaces-1/reporting", TestURIReportingDeploymentsCountedByWeek)
+	history, err = service.GetDeploymentHistory(DeploymentHistoryQuery{})
+	require.Error(t, err)
+	require.Nil(t, history)
+}
+
+func TestDeploymentHistoryFilterAndCountByWeek(t *testing.T) {
+	history, err := ParseDeploymentHistory(strings.NewReader(testDeploymentHistoryResponse))
+	require.NoError(t, err)
+
+	from := time.Date(2020, 6, 2, 0, 0, 0, 0, time.UTC)
+	filtered := history.Filter(DeploymentHistoryQuery{FromCompletedTime: &from})
+	require.Len(t, filtered.Items, 2)
+
+	filtered = history.Filter(DeploymentHistoryQuery{TenantIDs: []string{"Tenants-1"}})
+	require.Len(t, filtered.Items, 1)
+	require.Equal(t, "Acme", filtered.Items[0].TenantName)
+
+	deploymentsCountedByWeek := history.CountByWeek()
+	require.Len(t, deploymentsCountedByWeek.Items, 2)
+	require.Equal(t, 3, deploymentsCountedByWeek.TotalCount)
+	require.Equal(t, time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC), *deploymentsCountedByWeek.Items[0].WeekStart)
+	require.Equal(t, 2, deploymentsCountedByWeek.Items[0].Count)
+	require.Equal(t, time.Date(2020, 6, 8, 0, 0, 0, 0, time.UTC), *deploymentsCountedByWeek.Items[1].WeekStart)
+	require.Equal(t, 1, deploymentsCountedByWeek.Items[1].Count)
+
+	_, err = ParseDeploymentHistory(strings.NewReader(`<Deployments><Deployment><CompletedTime>yesterday</CompletedTime></Deployment></Deployments>`))
+	require.Error(t, err)
+}
+
+func TestDeploymentHistoryExport(t *testing.T) {
+	history, err := ParseDeploymentHistory(strings.NewReader(testDeploymentHistoryResponse))
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+	require.NoError(t, history.WriteCSV(&buffer))
+
+	records, err := csv.NewReader(&buffer).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 5)
+	require.Equal(t, deploymentHistoryColumns, records[0])
+	require.Equal(t, "Deployments-1", records[1][0])
+	require.Equal(t, "2020-06-01T10:00:00Z", records[1][16])
+	require.Equal(t, "", records[4][16])
+
+	buffer.Reset()
+	require.NoError(t, history.WriteJSON(&buffer))
+
+	decoded := new(DeploymentHistory)
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), decoded))
+	require.Len(t, decoded.Items, 4)
+	require.Equal(t, history.Items[0].ReleaseVersion, decoded.Items[0].ReleaseVersion)
+}
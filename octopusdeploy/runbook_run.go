@@ -0,0 +1,57 @@
+package octopusdeploy
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// RunbookRun is a run of a snapshot of a runbook in an environment.
+type RunbookRun struct {
+	Comments               string            `json:"Comments,omitempty"`
+	Created                *time.Time        `json:"Created,omitempty"`
+	EnvironmentID          string            `json:"EnvironmentId" validate:"required"`
+	ExcludedMachineIDs     []string          `json:"ExcludedMachineIds"`
+	FailureEncountered     bool              `json:"FailureEncountered,omitempty"`
+	ForcePackageDownload   bool              `json:"ForcePackageDownload,omitempty"`
+	FormValues             map[string]string `json:"FormValues,omitempty"`
+	FrozenRunbookProcessID string            `json:"FrozenRunbookProcessId,omitempty"`
+	Name                   string            `json:"Name,omitempty"`
+	ProjectID              string            `json:"ProjectId,omitempty"`
+	QueueTime              *time.Time        `json:"QueueTime,omitempty"`
+	QueueTimeExpiry        *time.Time        `json:"QueueTimeExpiry,omitempty"`
+	RunbookID              string            `json:"RunbookId" validate:"required"`
+	RunbookSnapshotID      string            `json:"RunbookSnapshotId" validate:"required"`
+	SkipActions            []string          `json:"SkipActions"`
+	SpaceID                string            `json:"SpaceId,omitempty"`
+	SpecificMachineIDs     []string          `json:"SpecificMachineIds"`
+	TaskID                 string            `json:"TaskId,omitempty"`
+	TenantID               string            `json:"TenantId,omitempty"`
+	UseGuidedFailure       bool              `json:"UseGuidedFailure,omitempty"`
+
+	resource
+}
+
+// RunbookRuns defines a collection of runbook runs with built-in support for
+// paged results.
+type RunbookRuns struct {
+	Items []*RunbookRun `json:"Items"`
+	PagedResults
+}
+
+// NewRunbookRun initializes a run of a snapshot of a runbook in an
+// environment.
+func NewRunbookRun(runbookID string, runbookSnapshotID string, environmentID string) *RunbookRun {
+	return &RunbookRun{
+		EnvironmentID:     environmentID,
+		RunbookID:         runbookID,
+		RunbookSnapshotID: runbookSnapshotID,
+		resource:          *newResource(),
+	}
+}
+
+// Validate checks the state of the runbook run and returns an error if
+// invalid.
+func (r *RunbookRun) Validate() error {
+	return validator.New().Struct(r)
+}
@@ -1,6 +1,9 @@
 package octopusdeploy
 
-import "github.com/dghubble/sling"
+import (
+	"github.com/dghubble/sling"
+	"github.com/google/go-querystring/query"
+)
 
 type runbookRunService struct {
 	canDeleteService
@@ -12,3 +15,58 @@ func newRunbookRunService(sling *sling.Sling, uriTemplate string) *runbookRunSer
 
 	return runbookRunService
 }
+
+// Add creates a new runbook run.
+func (s runbookRunService) Add(resource *RunbookRun) (*RunbookRun, error) {
+	path, err := getAddPath(s, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiAdd(s.getClient(), resource, new(RunbookRun), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*RunbookRun), nil
+}
+
+// Get returns a collection of runbook runs based on the criteria defined by
+// its input query parameter. If an error occurs, an empty collection is
+// returned along with the associated error.
+func (s runbookRunService) Get(runbookRunsQuery RunbookRunsQuery) (*RunbookRuns, error) {
+	err := validateInternalState(s)
+	if err != nil {
+		return &RunbookRuns{}, err
+	}
+
+	v, _ := query.Values(runbookRunsQuery)
+	path := s.BasePath
+	encodedQueryString := v.Encode()
+	if len(encodedQueryString) > 0 {
+		path += "?" + encodedQueryString
+	}
+
+	resp, err := apiGet(s.getClient(), new(RunbookRuns), path)
+	if err != nil {
+		return &RunbookRuns{}, err
+	}
+
+	return resp.(*RunbookRuns), nil
+}
+
+// GetByID returns the runbook run that matches the input ID. If one cannot be
+// found, it returns nil and an error.
+func (s runbookRunService) GetByID(id string) (*RunbookRun, error) {
+	path, err := getByIDPath(s, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiGet(s.getClient(), new(RunbookRun), path)
+	if err != nil {
+		return nil, createResourceNotFoundError(s.getName(), "ID", id)
+	}
+
+	return resp.(*RunbookRun), nil
+}
@@ -0,0 +1,127 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// ScopedUserRoles defines a collection of scoped user roles with built-in
+// support for paged results.
+type ScopedUserRoles struct {
+	Items []*ScopedUserRole `json:"Items"`
+	PagedResults
+}
+
+// ScopedUserRole grants a user role to a team, optionally restricted to a
+// space and to sets of projects, project groups, environments and tenants.
+type ScopedUserRole struct {
+	EnvironmentIDs  []string `json:"EnvironmentIds"`
+	ProjectGroupIDs []string `json:"ProjectGroupIds"`
+	ProjectIDs      []string `json:"ProjectIds"`
+	SpaceID         string   `json:"SpaceId,omitempty"`
+	TeamID          string   `json:"TeamId" validate:"required"`
+	TenantIDs       []string `json:"TenantIds"`
+	UserRoleID      string   `json:"UserRoleId" validate:"required"`
+
+	resource
+}
+
+// NewScopedUserRole initializes a scoped user role that grants a user role to
+// a team.
+func NewScopedUserRole(teamID string, userRoleID string) *ScopedUserRole {
+	return &ScopedUserRole{
+		EnvironmentIDs:  []string{},
+		ProjectGroupIDs: []string{},
+		ProjectIDs:      []string{},
+		TeamID:          teamID,
+		TenantIDs:       []string{},
+		UserRoleID:      userRoleID,
+		resource:        *newResource(),
+	}
+}
+
+// Validate checks the state of the scoped user role and returns an error if
+// invalid.
+func (s *ScopedUserRole) Validate() error {
+	return validator.New().Struct(s)
+}
+
+// getRestrictions returns the restrictions applied by the scopes of the scoped
+// user role.
+func (s *ScopedUserRole) getRestrictions() []PermissionRestriction {
+	restrictions := []PermissionRestriction{}
+
+	if len(s.EnvironmentIDs) > 0 {
+		restrictions = append(restrictions, PermissionRestrictionEnvironments)
+	}
+
+	if len(s.ProjectGroupIDs) > 0 {
+		restrictions = append(restrictions, PermissionRestrictionProjectGroups)
+	}
+
+	if len(s.ProjectIDs) > 0 {
+		restrictions = append(restrictions, PermissionRestrictionProjects)
+	}
+
+	if len(s.TenantIDs) > 0 {
+		restrictions = append(restrictions, PermissionRestrictionTenants)
+	}
+
+	return restrictions
+}
+
+// ValidateScopes checks that every space permission granted by the input user
+// role supports the scopes of the scoped user role, using the permission
+// catalog returned by the permission service. It returns an error naming the
+// first permission that cannot be restricted to a scope.
+func (s *ScopedUserRole) ValidateScopes(userRole *UserRole, permissions map[string]*PermissionDescription) error {
+	if userRole == nil {
+		return createInvalidParameterError(OperationValidateScopedUserRole, ParameterUserRole)
+	}
+
+	if permissions == nil {
+		return createInvalidParameterError(OperationValidateScopedUserRole, ParameterPermissions)
+	}
+
+	restrictions := s.getRestrictions()
+	if len(restrictions) == 0 {
+		return nil
+	}
+
+	for _, permission := range userRole.GrantedSpacePermissions {
+		description, ok := permissions[permission]
+		if !ok || description == nil {
+			return fmt.Errorf("%s: the permission (%s) granted by the user role (%s) is not a known permission", OperationValidateScopedUserRole, permission, userRole.Name)
+		}
+
+		for _, restriction := range restrictions {
+			if !description.SupportsRestriction(restriction) {
+				return fmt.Errorf("%s: the permission (%s) granted by the user role (%s) does not support the scope (%s)", OperationValidateScopedUserRole, permission, userRole.Name, restriction)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateScopedUserRole checks that every space permission granted by the
+// user role of the input scoped user role supports its scopes. It loads the
+// user role and the permission catalog from the Octopus server.
+func (c *Client) ValidateScopedUserRole(scopedUserRole *ScopedUserRole) error {
+	if scopedUserRole == nil {
+		return createInvalidParameterError(OperationValidateScopedUserRole, ParameterScopedUserRole)
+	}
+
+	userRole, err := c.UserRoles.GetByID(scopedUserRole.UserRoleID)
+	if err != nil {
+		return err
+	}
+
+	permissions, err := c.Permissions.GetAll()
+	if err != nil {
+		return err
+	}
+
+	return scopedUserRole.ValidateScopes(userRole, permissions)
+}
@@ -1,6 +1,9 @@
 package octopusdeploy
 
-import "github.com/dghubble/sling"
+import (
+	"github.com/dghubble/sling"
+	"github.com/google/go-querystring/query"
+)
 
 type scopedUserRoleService struct {
 	canDeleteService
@@ -12,3 +15,112 @@ func newScopedUserRoleService(sling *sling.Sling, uriTemplate string) *scopedUse
 
 	return scopedUserRoleService
 }
+
+func (s scopedUserRoleService) getPagedResponse(path string) ([]*ScopedUserRole, error) {
+	resources := []*ScopedUserRole{}
+	loadNextPage := true
+
+	for loadNextPage {
+		resp, err := apiGet(s.getClient(), new(ScopedUserRoles), path)
+		if err != nil {
+			return resources, err
+		}
+
+		responseList := resp.(*ScopedUserRoles)
+		resources = append(resources, responseList.Items...)
+		path, loadNextPage = LoadNextPage(responseList.PagedResults)
+	}
+
+	return resources, nil
+}
+
+// Add creates a new scoped user role.
+func (s scopedUserRoleService) Add(scopedUserRole *ScopedUserRole) (*ScopedUserRole, error) {
+	if scopedUserRole == nil {
+		return nil, createInvalidParameterError(OperationAdd, ParameterScopedUserRole)
+	}
+
+	path, err := getAddPath(s, scopedUserRole)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiAdd(s.getClient(), scopedUserRole, new(ScopedUserRole), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*ScopedUserRole), nil
+}
+
+// Get returns a collection of scoped user roles based on the criteria defined
+// by its input query parameter. If an error occurs, an empty collection is
+// returned along with the associated error.
+func (s scopedUserRoleService) Get(scopedUserRolesQuery ScopedUserRolesQuery) (*ScopedUserRoles, error) {
+	v, _ := query.Values(scopedUserRolesQuery)
+	path := s.BasePath
+	encodedQueryString := v.Encode()
+	if len(encodedQueryString) > 0 {
+		path += "?" + encodedQueryString
+	}
+
+	resp, err := apiGet(s.getClient(), new(ScopedUserRoles), path)
+	if err != nil {
+		return &ScopedUserRoles{}, err
+	}
+
+	return resp.(*ScopedUserRoles), nil
+}
+
+// GetAll returns every scoped user role that matches the input query
+// parameter, following paged results until the last page is loaded.
+func (s scopedUserRoleService) GetAll(scopedUserRolesQuery ScopedUserRolesQuery) ([]*ScopedUserRole, error) {
+	err := validateInternalState(s)
+	if err != nil {
+		return []*ScopedUserRole{}, err
+	}
+
+	v, _ := query.Values(scopedUserRolesQuery)
+	path := s.BasePath
+	encodedQueryString := v.Encode()
+	if len(encodedQueryString) > 0 {
+		path += "?" + encodedQueryString
+	}
+
+	return s.getPagedResponse(path)
+}
+
+// GetByID returns the scoped user role that matches the input ID. If one
+// cannot be found, it returns nil and an error.
+func (s scopedUserRoleService) GetByID(id string) (*ScopedUserRole, error) {
+	path, err := getByIDPath(s, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiGet(s.getClient(), new(ScopedUserRole), path)
+	if err != nil {
+		return nil, createResourceNotFoundError(s.getName(), "ID", id)
+	}
+
+	return resp.(*ScopedUserRole), nil
+}
+
+// Update modifies a scoped user role based on the one provided as input.
+func (s scopedUserRoleService) Update(scopedUserRole *ScopedUserRole) (*ScopedUserRole, error) {
+	if scopedUserRole == nil {
+		return nil, createInvalidParameterError(OperationUpdate, ParameterScopedUserRole)
+	}
+
+	path, err := getUpdatePath(s, scopedUserRole)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiUpdate(s.getClient(), scopedUserRole, new(ScopedUserRole), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*ScopedUserRole), nil
+}
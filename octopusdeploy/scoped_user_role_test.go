@@ -0,0 +1,44 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScopedUserRoleNew(t *testing.T) {
+	scopedUserRole := NewScopedUserRole(emptyString, emptyString)
+	require.Error(t, scopedUserRole.Validate())
+
+	scopedUserRole = NewScopedUserRole("Teams-1", "UserRoles-1")
+	require.NoError(t, scopedUserRole.Validate())
+}
+
+func TestScopedUserRoleValidateScopes(t *testing.T) {
+	permissions := map[string]*PermissionDescription{
+		"DeploymentCreate": {SupportedRestrictions: []string{string(PermissionRestrictionEnvironments), string(PermissionRestrictionProjects), string(PermissionRestrictionTenants)}},
+		"ProjectView":      {SupportedRestrictions: []string{string(PermissionRestrictionProjects), string(PermissionRestrictionProjectGroups)}},
+	}
+
+	userRole := NewUserRole("Deployer")
+	userRole.GrantedSpacePermissions = []string{"DeploymentCreate", "ProjectView"}
+
+	scopedUserRole := NewScopedUserRole("Teams-1", "UserRoles-1")
+	require.Equal(t, createInvalidParameterError(OperationValidateScopedUserRole, ParameterUserRole), scopedUserRole.ValidateScopes(nil, permissions))
+	require.Equal(t, createInvalidParameterError(OperationValidateScopedUserRole, ParameterPermissions), scopedUserRole.ValidateScopes(userRole, nil))
+	require.NoError(t, scopedUserRole.ValidateScopes(userRole, permissions))
+
+	scopedUserRole.ProjectIDs = []string{"Projects-1"}
+	require.NoError(t, scopedUserRole.ValidateScopes(userRole, permissions))
+
+	scopedUserRole.EnvironmentIDs = []string{"Environments-1"}
+	err := scopedUserRole.ValidateScopes(userRole, permissions)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "ProjectView")
+	require.Contains(t, err.Error(), string(PermissionRestrictionEnvironments))
+
+	userRole.GrantedSpacePermissions = []string{"DeploymentCreate", "Unknown"}
+	err = scopedUserRole.ValidateScopes(userRole, permissions)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Unknown")
+}
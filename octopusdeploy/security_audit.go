@@ -0,0 +1,459 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// administratorsTeamID is the ID of the built-in Octopus Administrators
+	// team.
+	administratorsTeamID = "teams-administrators"
+
+	// permissionAdministerSystem is the system permission held by system
+	// administrators.
+	permissionAdministerSystem = "AdministerSystem"
+)
+
+// SecurityAuditOptions configures the thresholds of a security audit.
+type SecurityAuditOptions struct {
+	// APIKeyMaxAge is the age above which the API keys of service accounts
+	// are reported. Zero disables the check.
+	APIKeyMaxAge time.Duration
+
+	// CertificateExpiryWindow is how far ahead certificates that are about
+	// to expire are reported. Expired certificates are always reported.
+	CertificateExpiryWindow time.Duration
+}
+
+// SecurityAuditInput holds the resources that a security audit is built from.
+// API keys and account usages are keyed by user and account ID.
+type SecurityAuditInput struct {
+	Accounts        []IAccount
+	AccountUsages   map[string]*AccountUsage
+	APIKeys         map[string][]*APIKey
+	Certificates    []*CertificateResource
+	ScopedUserRoles []*ScopedUserRole
+	Teams           []*Team
+	UserRoles       []*UserRole
+	Users           []*User
+}
+
+// SecurityAudit is a report of security findings across an Octopus instance.
+type SecurityAudit struct {
+	ExpiringCertificates          []*SecurityAuditCertificate `json:"ExpiringCertificates"`
+	GeneratedAt                   time.Time                   `json:"GeneratedAt"`
+	InactiveUsersWithAPIKeys      []*SecurityAuditUser        `json:"InactiveUsersWithApiKeys"`
+	Options                       SecurityAuditOptions        `json:"Options"`
+	ServiceAccountsWithOldAPIKeys []*APIKeyAgeReportItem      `json:"ServiceAccountsWithOldApiKeys"`
+	SystemAdministrators          []*SecurityAuditUser        `json:"SystemAdministrators"`
+	TeamsWithoutMembers           []*SecurityAuditTeam        `json:"TeamsWithoutMembers"`
+	UnusedAccounts                []*SecurityAuditAccount     `json:"UnusedAccounts"`
+}
+
+// SecurityAuditAccount identifies an account in a security audit.
+type SecurityAuditAccount struct {
+	AccountID   string      `json:"AccountId"`
+	AccountType AccountType `json:"AccountType"`
+	Name        string      `json:"Name"`
+	SpaceID     string      `json:"SpaceId,omitempty"`
+}
+
+// SecurityAuditCertificate identifies a certificate in a security audit.
+type SecurityAuditCertificate struct {
+	CertificateID string     `json:"CertificateId"`
+	IsExpired     bool       `json:"IsExpired"`
+	Name          string     `json:"Name"`
+	NotAfter      *time.Time `json:"NotAfter,omitempty"`
+	Thumbprint    string     `json:"Thumbprint,omitempty"`
+}
+
+// SecurityAuditTeam identifies a team in a security audit.
+type SecurityAuditTeam struct {
+	Name    string `json:"Name"`
+	SpaceID string `json:"SpaceId,omitempty"`
+	TeamID  string `json:"TeamId"`
+}
+
+// SecurityAuditUser identifies a user in a security audit, with the API keys
+// or teams that caused it to be reported.
+type SecurityAuditUser struct {
+	APIKeyIDs   []string `json:"ApiKeyIds,omitempty"`
+	DisplayName string   `json:"DisplayName,omitempty"`
+	IsActive    bool     `json:"IsActive"`
+	IsService   bool     `json:"IsService"`
+	Teams       []string `json:"Teams,omitempty"`
+	UserID      string   `json:"UserId"`
+	Username    string   `json:"Username"`
+}
+
+// BuildSecurityAudit builds a security audit from the input resources as of
+// the input time. It reports:
+//
+//   - users who are members of a team that holds the AdministerSystem
+//     permission, including the built-in Octopus Administrators team;
+//   - inactive users who still hold API keys;
+//   - service accounts with API keys older than the maximum age;
+//   - teams without members or external security groups, other than the
+//     built-in Everyone team;
+//   - accounts that are not used by any process, variable set, release,
+//     runbook or deployment target;
+//   - certificates that are expired or expire within the expiry window.
+//     Archived certificates are ignored.
+func BuildSecurityAudit(input SecurityAuditInput, options SecurityAuditOptions, asOf time.Time) *SecurityAudit {
+	audit := &SecurityAudit{
+		ExpiringCertificates:          []*SecurityAuditCertificate{},
+		GeneratedAt:                   asOf,
+		InactiveUsersWithAPIKeys:      []*SecurityAuditUser{},
+		Options:                       options,
+		ServiceAccountsWithOldAPIKeys: []*APIKeyAgeReportItem{},
+		SystemAdministrators:          []*SecurityAuditUser{},
+		TeamsWithoutMembers:           []*SecurityAuditTeam{},
+		UnusedAccounts:                []*SecurityAuditAccount{},
+	}
+
+	newSecurityAuditUser := func(user *User) *SecurityAuditUser {
+		return &SecurityAuditUser{
+			DisplayName: user.DisplayName,
+			IsActive:    user.IsActive,
+			IsService:   user.IsService,
+			UserID:      user.GetID(),
+			Username:    user.Username,
+		}
+	}
+
+	// system administrators
+	userRolesByID := map[string]*UserRole{}
+	for _, userRole := range input.UserRoles {
+		if userRole != nil {
+			userRolesByID[userRole.GetID()] = userRole
+		}
+	}
+
+	administratorTeamIDs := []string{administratorsTeamID}
+	for _, scopedUserRole := range input.ScopedUserRoles {
+		if scopedUserRole == nil {
+			continue
+		}
+		if userRole, ok := userRolesByID[scopedUserRole.UserRoleID]; ok && ValidateStringInSlice(permissionAdministerSystem, userRole.GrantedSystemPermissions) {
+			administratorTeamIDs = append(administratorTeamIDs, scopedUserRole.TeamID)
+		}
+	}
+
+	administratorTeams := map[string][]string{}
+	for _, team := range input.Teams {
+		if team == nil || !ValidateStringInSlice(team.GetID(), administratorTeamIDs) {
+			continue
+		}
+
+		memberUserIDs := team.MemberUserIDs
+		if team.GetID() == everyoneTeamID {
+			memberUserIDs = []string{}
+			for _, user := range input.Users {
+				if user != nil {
+					memberUserIDs = append(memberUserIDs, user.GetID())
+				}
+			}
+		}
+
+		for _, userID := range memberUserIDs {
+			if !ValidateStringInSlice(team.Name, administratorTeams[userID]) {
+				administratorTeams[userID] = append(administratorTeams[userID], team.Name)
+			}
+		}
+	}
+
+	for _, user := range input.Users {
+		if user == nil {
+			continue
+		}
+
+		if teams, ok := administratorTeams[user.GetID()]; ok {
+			item := newSecurityAuditUser(user)
+			item.Teams = teams
+			sort.Strings(item.Teams)
+			audit.SystemAdministrators = append(audit.SystemAdministrators, item)
+		}
+
+		if !user.IsActive && len(input.APIKeys[user.GetID()]) > 0 {
+			item := newSecurityAuditUser(user)
+			item.APIKeyIDs = []string{}
+			for _, apiKey := range input.APIKeys[user.GetID()] {
+				if apiKey != nil {
+					item.APIKeyIDs = append(item.APIKeyIDs, apiKey.GetID())
+				}
+			}
+			audit.InactiveUsersWithAPIKeys = append(audit.InactiveUsersWithAPIKeys, item)
+		}
+	}
+
+	sort.SliceStable(audit.SystemAdministrators, func(i, j int) bool {
+		return audit.SystemAdministrators[i].Username < audit.SystemAdministrators[j].Username
+	})
+	sort.SliceStable(audit.InactiveUsersWithAPIKeys, func(i, j int) bool {
+		return audit.InactiveUsersWithAPIKeys[i].Username < audit.InactiveUsersWithAPIKeys[j].Username
+	})
+
+	// service accounts with old API keys
+	if options.APIKeyMaxAge > 0 {
+		for _, item := range BuildAPIKeyAgeReport(input.Users, input.APIKeys, options.APIKeyMaxAge, asOf).Items {
+			if item.IsService {
+				audit.ServiceAccountsWithOldAPIKeys = append(audit.ServiceAccountsWithOldAPIKeys, item)
+			}
+		}
+	}
+
+	// teams without members
+	for _, team := range input.Teams {
+		if team == nil || team.GetID() == everyoneTeamID {
+			continue
+		}
+
+		if len(team.MemberUserIDs) == 0 && len(team.ExternalSecurityGroups) == 0 {
+			audit.TeamsWithoutMembers = append(audit.TeamsWithoutMembers, &SecurityAuditTeam{
+				Name:    team.Name,
+				SpaceID: team.SpaceID,
+				TeamID:  team.GetID(),
+			})
+		}
+	}
+
+	sort.SliceStable(audit.TeamsWithoutMembers, func(i, j int) bool {
+		return audit.TeamsWithoutMembers[i].Name < audit.TeamsWithoutMembers[j].Name
+	})
+
+	// unused accounts
+	for _, account := range input.Accounts {
+		if account == nil {
+			continue
+		}
+
+		if usage, ok := input.AccountUsages[account.GetID()]; ok && !isAccountUsed(usage) {
+			audit.UnusedAccounts = append(audit.UnusedAccounts, &SecurityAuditAccount{
+				AccountID:   account.GetID(),
+				AccountType: account.GetAccountType(),
+				Name:        account.GetName(),
+				SpaceID:     account.GetSpaceID(),
+			})
+		}
+	}
+
+	sort.SliceStable(audit.UnusedAccounts, func(i, j int) bool {
+		return audit.UnusedAccounts[i].Name < audit.UnusedAccounts[j].Name
+	})
+
+	// expired and expiring certificates
+	expiresBefore := asOf.Add(options.CertificateExpiryWindow)
+	for _, certificate := range input.Certificates {
+		if certificate == nil || !isEmpty(certificate.Archived) {
+			continue
+		}
+
+		item := &SecurityAuditCertificate{
+			CertificateID: certificate.GetID(),
+			IsExpired:     certificate.IsExpired,
+			Name:          certificate.Name,
+			Thumbprint:    certificate.Thumbprint,
+		}
+
+		if notAfter, err := time.Parse(time.RFC3339, certificate.NotAfter); err == nil {
+			item.NotAfter = &notAfter
+			item.IsExpired = item.IsExpired || !notAfter.After(asOf)
+		}
+
+		if item.IsExpired || (item.NotAfter != nil && item.NotAfter.Before(expiresBefore)) {
+			audit.ExpiringCertificates = append(audit.ExpiringCertificates, item)
+		}
+	}
+
+	sort.SliceStable(audit.ExpiringCertificates, func(i, j int) bool {
+		a, b := audit.ExpiringCertificates[i], audit.ExpiringCertificates[j]
+		if a.NotAfter == nil || b.NotAfter == nil {
+			return a.NotAfter == nil && b.NotAfter != nil
+		}
+		return a.NotAfter.Before(*b.NotAfter)
+	})
+
+	return audit
+}
+
+// isAccountUsed returns true if the usage lists anything that uses the
+// account.
+func isAccountUsed(usage *AccountUsage) bool {
+	return len(usage.DeploymentProcesses) > 0 ||
+		len(usage.LibraryVariableSets) > 0 ||
+		len(usage.ProjectVariableSets) > 0 ||
+		len(usage.Releases) > 0 ||
+		len(usage.RunbookProcesses) > 0 ||
+		len(usage.RunbookSnapshots) > 0 ||
+		len(usage.Targets) > 0
+}
+
+// GetFindingCount returns the total number of findings in the audit.
+func (a *SecurityAudit) GetFindingCount() int {
+	return len(a.ExpiringCertificates) +
+		len(a.InactiveUsersWithAPIKeys) +
+		len(a.ServiceAccountsWithOldAPIKeys) +
+		len(a.SystemAdministrators) +
+		len(a.TeamsWithoutMembers) +
+		len(a.UnusedAccounts)
+}
+
+// WriteJSON writes the audit to the input writer as indented JSON.
+func (a *SecurityAudit) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent(empty, tab)
+	return encoder.Encode(a)
+}
+
+// WriteMarkdown writes the audit to the input writer as a Markdown document
+// with a table per finding.
+func (a *SecurityAudit) WriteMarkdown(w io.Writer) error {
+	document := &markdownWriter{w: w}
+
+	document.printf("# Security audit\n\nGenerated at %s.\n", a.GeneratedAt.UTC().Format(time.RFC3339))
+
+	rows := [][]string{}
+	for _, user := range a.SystemAdministrators {
+		rows = append(rows, []string{user.Username, user.DisplayName, strings.Join(user.Teams, ", "), strconv.FormatBool(user.IsActive), strconv.FormatBool(user.IsService)})
+	}
+	document.table("Users with system administrator teams", []string{"Username", "Display name", "Teams", "Active", "Service account"}, rows)
+
+	rows = [][]string{}
+	for _, user := range a.InactiveUsersWithAPIKeys {
+		rows = append(rows, []string{user.Username, user.DisplayName, strings.Join(user.APIKeyIDs, ", ")})
+	}
+	document.table("Inactive users with API keys", []string{"Username", "Display name", "API keys"}, rows)
+
+	rows = [][]string{}
+	for _, item := range a.ServiceAccountsWithOldAPIKeys {
+		rows = append(rows, []string{item.Username, item.APIKeyID, item.Purpose, formatDays(item.Age)})
+	}
+	document.table(fmt.Sprintf("Service accounts with API keys older than %s", formatDays(a.Options.APIKeyMaxAge)), []string{"Username", "API key", "Purpose", "Age"}, rows)
+
+	rows = [][]string{}
+	for _, team := range a.TeamsWithoutMembers {
+		rows = append(rows, []string{team.Name, team.TeamID, team.SpaceID})
+	}
+	document.table("Teams without members", []string{"Name", "ID", "Space"}, rows)
+
+	rows = [][]string{}
+	for _, account := range a.UnusedAccounts {
+		rows = append(rows, []string{account.Name, account.AccountID, string(account.AccountType), account.SpaceID})
+	}
+	document.table("Unused accounts", []string{"Name", "ID", "Type", "Space"}, rows)
+
+	rows = [][]string{}
+	for _, certificate := range a.ExpiringCertificates {
+		notAfter := empty
+		if certificate.NotAfter != nil {
+			notAfter = certificate.NotAfter.UTC().Format(time.RFC3339)
+		}
+		rows = append(rows, []string{certificate.Name, certificate.CertificateID, notAfter, strconv.FormatBool(certificate.IsExpired)})
+	}
+	document.table(fmt.Sprintf("Certificates expired or expiring within %s", formatDays(a.Options.CertificateExpiryWindow)), []string{"Name", "ID", "Not after", "Expired"}, rows)
+
+	return document.err
+}
+
+// formatDays formats a duration as a whole number of days.
+func formatDays(d time.Duration) string {
+	days := int(d / (24 * time.Hour))
+	if days == 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%d days", days)
+}
+
+// markdownWriter writes Markdown to a writer and keeps the first error.
+type markdownWriter struct {
+	err error
+	w   io.Writer
+}
+
+func (m *markdownWriter) printf(format string, a ...interface{}) {
+	if m.err != nil {
+		return
+	}
+	_, m.err = fmt.Fprintf(m.w, format, a...)
+}
+
+func (m *markdownWriter) table(title string, header []string, rows [][]string) {
+	m.printf("\n## %s (%d)\n\n", title, len(rows))
+	if len(rows) == 0 {
+		m.printf("None.\n")
+		return
+	}
+
+	separator := make([]string, len(header))
+	for i := range separator {
+		separator[i] = "---"
+	}
+
+	m.printf("| %s |\n", strings.Join(header, " | "))
+	m.printf("| %s |\n", strings.Join(separator, " | "))
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = strings.NewReplacer("|", `\|`, "\n", " ").Replace(cell)
+		}
+		m.printf("| %s |\n", strings.Join(cells, " | "))
+	}
+}
+
+// AuditSecurity loads users, API keys, teams, user roles, accounts and
+// certificates from the Octopus server and builds a security audit.
+func (c *Client) AuditSecurity(options SecurityAuditOptions) (*SecurityAudit, error) {
+	input := SecurityAuditInput{
+		AccountUsages: map[string]*AccountUsage{},
+		APIKeys:       map[string][]*APIKey{},
+	}
+
+	var err error
+	if input.Users, err = c.Users.GetAll(); err != nil {
+		return nil, err
+	}
+
+	for _, user := range input.Users {
+		apiKeys, err := c.APIKeys.GetByUserID(user.GetID())
+		if err != nil {
+			return nil, err
+		}
+		input.APIKeys[user.GetID()] = apiKeys
+	}
+
+	if input.Teams, err = c.Teams.GetAll(); err != nil {
+		return nil, err
+	}
+
+	if input.ScopedUserRoles, err = c.ScopedUserRoles.GetAll(ScopedUserRolesQuery{IncludeSystem: true}); err != nil {
+		return nil, err
+	}
+
+	if input.UserRoles, err = c.UserRoles.GetAll(); err != nil {
+		return nil, err
+	}
+
+	if input.Accounts, err = c.Accounts.GetAll(); err != nil {
+		return nil, err
+	}
+
+	for _, account := range input.Accounts {
+		usage, err := c.Accounts.GetUsages(account)
+		if err != nil {
+			return nil, err
+		}
+		input.AccountUsages[account.GetID()] = usage
+	}
+
+	if input.Certificates, err = c.Certificates.GetAll(); err != nil {
+		return nil, err
+	}
+
+	return BuildSecurityAudit(input, options, time.Now()), nil
+}
@@ -0,0 +1,176 @@
+package octopusdeploy
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createSecurityAuditInput(asOf time.Time) SecurityAuditInput {
+	created := func(days int) *time.Time {
+		t := asOf.AddDate(0, 0, -days)
+		return &t
+	}
+
+	usedAccount := NewAccountResource("Azure", AccountTypeAzureServicePrincipal)
+	usedAccount.ID = "Accounts-1"
+	unusedAccount := NewAccountResource("Legacy AWS", AccountTypeAmazonWebServicesAccount)
+	unusedAccount.ID = "Accounts-2"
+	unknownAccount := NewAccountResource("Unknown", AccountTypeAmazonWebServicesAccount)
+	unknownAccount.ID = "Accounts-3"
+
+	return SecurityAuditInput{
+		Accounts: []IAccount{usedAccount, unusedAccount, unknownAccount},
+		AccountUsages: map[string]*AccountUsage{
+			"Accounts-1": {Targets: []*TargetUsageEntry{{}}},
+			"Accounts-2": {},
+		},
+		APIKeys: map[string][]*APIKey{
+			"Users-2": {{Created: created(5), resource: resource{ID: "APIKeys-1"}}},
+			"Users-3": {
+				{Created: created(200), Purpose: "ci", resource: resource{ID: "APIKeys-2"}},
+				{Created: created(10), Purpose: "cd", resource: resource{ID: "APIKeys-3"}},
+			},
+			"Users-4": {{Created: created(200), resource: resource{ID: "APIKeys-4"}}},
+		},
+		Certificates: []*CertificateResource{
+			{Name: "expired", NotAfter: asOf.AddDate(0, 0, -1).Format(time.RFC3339), resource: resource{ID: "Certificates-1"}},
+			{Name: "expiring", NotAfter: asOf.AddDate(0, 0, 10).Format(time.RFC3339), resource: resource{ID: "Certificates-2"}},
+			{Name: "valid", NotAfter: asOf.AddDate(1, 0, 0).Format(time.RFC3339), resource: resource{ID: "Certificates-3"}},
+			{Archived: asOf.Format(time.RFC3339), Name: "archived", NotAfter: asOf.AddDate(0, 0, -1).Format(time.RFC3339), resource: resource{ID: "Certificates-4"}},
+			{IsExpired: true, Name: "flagged", resource: resource{ID: "Certificates-5"}},
+		},
+		ScopedUserRoles: []*ScopedUserRole{
+			{TeamID: "Teams-1", UserRoleID: "userroles-systemadministrator"},
+			{TeamID: "Teams-2", UserRoleID: "userroles-projectviewer"},
+		},
+		Teams: []*Team{
+			{MemberUserIDs: []string{"Users-1"}, Name: "Octopus Administrators", resource: resource{ID: administratorsTeamID}},
+			{MemberUserIDs: []string{"Users-1", "Users-3"}, Name: "Platform", resource: resource{ID: "Teams-1"}},
+			{MemberUserIDs: []string{"Users-2"}, Name: "Viewers", resource: resource{ID: "Teams-2"}},
+			{Name: "Empty", SpaceID: "Spaces-1", resource: resource{ID: "Teams-3"}},
+			{ExternalSecurityGroups: []*NamedReferenceItem{{ID: "S-1-5-21-1"}}, Name: "External", resource: resource{ID: "Teams-4"}},
+			{Name: "Everyone", resource: resource{ID: everyoneTeamID}},
+		},
+		UserRoles: []*UserRole{
+			{GrantedSystemPermissions: []string{permissionAdministerSystem}, Name: "System administrator", resource: resource{ID: "userroles-systemadministrator"}},
+			{GrantedSpacePermissions: []string{"ProjectView"}, Name: "Project viewer", resource: resource{ID: "userroles-projectviewer"}},
+		},
+		Users: []*User{
+			{IsActive: true, Username: "admin", resource: resource{ID: "Users-1"}},
+			{Username: "former", resource: resource{ID: "Users-2"}},
+			{IsActive: true, IsService: true, Username: "deployer", resource: resource{ID: "Users-3"}},
+			{IsActive: true, Username: "person", resource: resource{ID: "Users-4"}},
+		},
+	}
+}
+
+func TestBuildSecurityAudit(t *testing.T) {
+	asOf := time.Date(2020, 9, 1, 0, 0, 0, 0, time.UTC)
+	options := SecurityAuditOptions{
+		APIKeyMaxAge:            90 * 24 * time.Hour,
+		CertificateExpiryWindow: 30 * 24 * time.Hour,
+	}
+
+	audit := BuildSecurityAudit(createSecurityAuditInput(asOf), options, asOf)
+
+	require.Len(t, audit.SystemAdministrators, 2)
+	require.Equal(t, "admin", audit.SystemAdministrators[0].Username)
+	require.Equal(t, []string{"Octopus Administrators", "Platform"}, audit.SystemAdministrators[0].Teams)
+	require.Equal(t, "deployer", audit.SystemAdministrators[1].Username)
+
+	require.Len(t, audit.InactiveUsersWithAPIKeys, 1)
+	require.Equal(t, "former", audit.InactiveUsersWithAPIKeys[0].Username)
+	require.Equal(t, []string{"APIKeys-1"}, audit.InactiveUsersWithAPIKeys[0].APIKeyIDs)
+
+	require.Len(t, audit.ServiceAccountsWithOldAPIKeys, 1)
+	require.Equal(t, "APIKeys-2", audit.ServiceAccountsWithOldAPIKeys[0].APIKeyID)
+
+	require.Len(t, audit.TeamsWithoutMembers, 1)
+	require.Equal(t, "Teams-3", audit.TeamsWithoutMembers[0].TeamID)
+
+	require.Len(t, audit.UnusedAccounts, 1)
+	require.Equal(t, "Accounts-2", audit.UnusedAccounts[0].AccountID)
+
+	require.Len(t, audit.ExpiringCertificates, 3)
+	require.Equal(t, "flagged", audit.ExpiringCertificates[0].Name)
+	require.Equal(t, "expired", audit.ExpiringCertificates[1].Name)
+	require.True(t, audit.ExpiringCertificates[1].IsExpired)
+	require.Equal(t, "expiring", audit.ExpiringCertificates[2].Name)
+	require.False(t, audit.ExpiringCertificates[2].IsExpired)
+
+	require.Equal(t, 9, audit.GetFindingCount())
+
+	// a zero maximum age disables the API key check
+	audit = BuildSecurityAudit(createSecurityAuditInput(asOf), SecurityAuditOptions{}, asOf)
+	require.Empty(t, audit.ServiceAccountsWithOldAPIKeys)
+	require.Len(t, audit.ExpiringCertificates, 2)
+}
+
+func TestSecurityAuditWrite(t *testing.T) {
+	asOf := time.Date(2020, 9, 1, 0, 0, 0, 0, time.UTC)
+	audit := BuildSecurityAudit(createSecurityAuditInput(asOf), SecurityAuditOptions{APIKeyMaxAge: 90 * 24 * time.Hour, CertificateExpiryWindow: 24 * time.Hour}, asOf)
+	audit.TeamsWithoutMembers[0].Name = "Empty | Team"
+
+	var buffer bytes.Buffer
+	require.NoError(t, audit.WriteMarkdown(&buffer))
+	markdown := buffer.String()
+	require.Contains(t, markdown, "# Security audit\n\nGenerated at 2020-09-01T00:00:00Z.\n")
+	require.Contains(t, markdown, "## Users with system administrator teams (2)\n\n| Username | Display name | Teams | Active | Service account |\n| --- | --- | --- | --- | --- |\n| admin |  | Octopus Administrators, Platform | true | false |\n")
+	require.Contains(t, markdown, "## Service accounts with API keys older than 90 days (1)")
+	require.Contains(t, markdown, `| Empty \| Team | Teams-3 | Spaces-1 |`)
+	require.Contains(t, markdown, "## Certificates expired or expiring within 1 day (2)")
+
+	audit = BuildSecurityAudit(SecurityAuditInput{}, SecurityAuditOptions{}, asOf)
+	buffer.Reset()
+	require.NoError(t, audit.WriteMarkdown(&buffer))
+	require.Contains(t, buffer.String(), "## Unused accounts (0)\n\nNone.\n")
+
+	buffer.Reset()
+	require.NoError(t, audit.WriteJSON(&buffer))
+	require.Contains(t, buffer.String(), `"UnusedAccounts": []`)
+}
+
+func TestAuditSecurity(t *testing.T) {
+	base := createFakeSling(func(r *http.Request) (int, string) {
+		switch r.URL.Path {
+		case "/api/users/all":
+			return http.StatusOK, `[{"Id":"Users-1","Username":"former"}]`
+		case "/api/users/Users-1/apikeys":
+			return http.StatusOK, `{"Items":[{"Id":"APIKeys-1"}]}`
+		case "/api/teams/all":
+			return http.StatusOK, `[{"Id":"Teams-1","Name":"Empty"}]`
+		case "/api/scopeduserroles":
+			return http.StatusOK, `{"Items":[]}`
+		case "/api/userroles/all":
+			return http.StatusOK, `[]`
+		case "/api/Spaces-1/accounts/all":
+			return http.StatusOK, `[{"Id":"Accounts-1","Name":"Unused","AccountType":"UsernamePassword","Links":{"Usages":"/api/Spaces-1/accounts/Accounts-1/usages"}}]`
+		case "/api/Spaces-1/accounts/Accounts-1/usages":
+			return http.StatusOK, `{}`
+		case "/api/Spaces-1/certificates/all":
+			return http.StatusOK, `[{"Id":"Certificates-1","Name":"expired","IsExpired":true}]`
+		}
+		return http.StatusNotFound, `{}`
+	})
+
+	client := &Client{
+		Accounts:        newAccountService(base, TestURIAccounts),
+		APIKeys:         newAPIKeyService(base, TestURIUsers),
+		Certificates:    newCertificateService(base, TestURICertificates),
+		ScopedUserRoles: newScopedUserRoleService(base, TestURIScopedUserRoles),
+		Teams:           newTeamService(base, TestURITeams),
+		UserRoles:       newUserRoleService(base, TestURIUserRoles),
+		Users:           newUserService(base, TestURIUsers, emptyString, emptyString, emptyString, emptyString, emptyString, emptyString, emptyString, emptyString, emptyString),
+	}
+
+	audit, err := client.AuditSecurity(SecurityAuditOptions{})
+	require.NoError(t, err)
+	require.Len(t, audit.InactiveUsersWithAPIKeys, 1)
+	require.Len(t, audit.TeamsWithoutMembers, 1)
+	require.Len(t, audit.UnusedAccounts, 1)
+	require.Len(t, audit.ExpiringCertificates, 1)
+}
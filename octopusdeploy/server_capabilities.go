@@ -0,0 +1,187 @@
+package octopusdeploy
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnsupportedByServer is matched by errors returned when the Octopus
+// server does not support an operation. Use errors.Is to check for it.
+var ErrUnsupportedByServer = errors.New("the operation is not supported by the Octopus server")
+
+// UnsupportedByServerError describes a capability that the Octopus server
+// does not support, naming the link relation that is missing from the root
+// resource and the minimum server version that provides it.
+type UnsupportedByServerError struct {
+	Capability     Capability
+	LinkRelation   string
+	MinimumVersion string
+	ServerVersion  string
+}
+
+func (e *UnsupportedByServerError) Error() string {
+	message := fmt.Sprintf("%s: %s is unavailable because the server does not advertise the link relation %s", ErrUnsupportedByServer, e.Capability, e.LinkRelation)
+	if !isEmpty(e.MinimumVersion) {
+		message += fmt.Sprintf("; Octopus %s or later is required", e.MinimumVersion)
+	}
+	if !isEmpty(e.ServerVersion) {
+		message += fmt.Sprintf(" (server version %s)", e.ServerVersion)
+	}
+	return message
+}
+
+// Is returns true if the target is ErrUnsupportedByServer.
+func (e *UnsupportedByServerError) Is(target error) bool {
+	return target == ErrUnsupportedByServer
+}
+
+type capabilityRequirement struct {
+	getService func(c *Client) *service
+
+	// getUnsupportedError returns the unsupported error of a path within a
+	// service, for capabilities that are a single operation of a service.
+	getUnsupportedError func(c *Client) *error
+	linkRelation        string
+	minimumVersion      string
+}
+
+// capabilityRequirements lists the link relation that each capability relies
+// on and, where known, the first server version that provides it.
+var capabilityRequirements = map[Capability]capabilityRequirement{
+	CapabilityBuildInformation: {
+		getService:     func(c *Client) *service { return &c.BuildInformation.service },
+		linkRelation:   linkBuildInformation,
+		minimumVersion: "2019.10.0",
+	},
+	CapabilityDashboardDynamic: {
+		getUnsupportedError: func(c *Client) *error { return &c.Dashboards.dynamicUnsupportedError },
+		linkRelation:        linkDashboardDynamic,
+	},
+	CapabilityDeploymentsCountedByWeek: {
+		getUnsupportedError: func(c *Client) *error { return &c.Reporting.deploymentsCountedByWeekUnsupportedError },
+		linkRelation:        linkReportingDeploymentsCountedByWeek,
+	},
+	CapabilityOctopusServerClusterSummary: {
+		getUnsupportedError: func(c *Client) *error { return &c.OctopusServerNodes.clusterSummaryUnsupportedError },
+		linkRelation:        linkOctopusServerClusterSummary,
+	},
+	CapabilityRunbooks: {
+		getService:     func(c *Client) *service { return &c.Runbooks.service },
+		linkRelation:   linkRunbooks,
+		minimumVersion: "2019.11.0",
+	},
+	CapabilityScopedUserRoles: {
+		getService:     func(c *Client) *service { return &c.ScopedUserRoles.service },
+		linkRelation:   linkScopedUserRoles,
+		minimumVersion: "2019.1.0",
+	},
+	CapabilitySpaces: {
+		getService:     func(c *Client) *service { return &c.Spaces.service },
+		linkRelation:   linkSpaces,
+		minimumVersion: "2019.1.0",
+	},
+	CapabilityTenants: {
+		getService:     func(c *Client) *service { return &c.Tenants.service },
+		linkRelation:   linkTenants,
+		minimumVersion: "3.4.0",
+	},
+	CapabilityTenantsMissingVariables: {
+		getUnsupportedError: func(c *Client) *error { return &c.Tenants.missingVariablesUnsupportedError },
+		linkRelation:        linkTenantsMissingVariables,
+		minimumVersion:      "3.4.0",
+	},
+	CapabilityTenantVariables: {
+		getService:     func(c *Client) *service { return &c.TenantVariables.service },
+		linkRelation:   linkTenantVariables,
+		minimumVersion: "3.4.0",
+	},
+	CapabilityWorkerPools: {
+		getService:     func(c *Client) *service { return &c.WorkerPools.service },
+		linkRelation:   linkWorkerPools,
+		minimumVersion: "2018.7.0",
+	},
+}
+
+// setServerCapabilities records the API and server versions reported by the
+// server root resource along with the link relations advertised by the
+// server and space root resources, and marks the services and operations
+// whose link relation is missing as unsupported.
+func (c *Client) setServerCapabilities(serverRoot *RootResource, links map[string]string) {
+	c.links = links
+
+	if serverRoot != nil {
+		c.apiVersion = serverRoot.APIVersion
+		c.serverVersion, _ = ParseServerVersion(serverRoot.Version)
+	}
+
+	for capability, requirement := range capabilityRequirements {
+		if c.Supports(capability) {
+			continue
+		}
+
+		if requirement.getService != nil {
+			if s := requirement.getService(c); s != nil {
+				s.unsupportedError = c.createUnsupportedByServerError(capability)
+			}
+		}
+
+		if requirement.getUnsupportedError != nil {
+			*requirement.getUnsupportedError(c) = c.createUnsupportedByServerError(capability)
+		}
+	}
+}
+
+func (c *Client) createUnsupportedByServerError(capability Capability) error {
+	requirement := capabilityRequirements[capability]
+	unsupportedByServerError := &UnsupportedByServerError{
+		Capability:     capability,
+		LinkRelation:   requirement.linkRelation,
+		MinimumVersion: requirement.minimumVersion,
+	}
+
+	if c.serverVersion != nil {
+		unsupportedByServerError.ServerVersion = c.serverVersion.String()
+	}
+
+	return unsupportedByServerError
+}
+
+// APIVersion returns the API version reported by the Octopus server.
+func (c *Client) APIVersion() string {
+	return c.apiVersion
+}
+
+// ServerVersion returns the version reported by the Octopus server or nil if
+// it could not be parsed.
+func (c *Client) ServerVersion() *ServerVersion {
+	return c.serverVersion
+}
+
+// Supports returns true if the Octopus server advertises the link relation
+// the capability relies on and, when the server version is known, the
+// server is at or above the minimum version for the capability.
+func (c *Client) Supports(capability Capability) bool {
+	requirement, ok := capabilityRequirements[capability]
+	if !ok {
+		return false
+	}
+
+	if isEmpty(c.links[requirement.linkRelation]) {
+		return false
+	}
+
+	if c.serverVersion != nil && !isEmpty(requirement.minimumVersion) {
+		return c.serverVersion.IsAtLeast(requirement.minimumVersion)
+	}
+
+	return true
+}
+
+// RequireCapability returns an UnsupportedByServerError if the Octopus
+// server does not support the input capability.
+func (c *Client) RequireCapability(capability Capability) error {
+	if c.Supports(capability) {
+		return nil
+	}
+	return c.createUnsupportedByServerError(capability)
+}
@@ -0,0 +1,167 @@
+package octopusdeploy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseServerVersion(t *testing.T) {
+	testCases := []struct {
+		name     string
+		version  string
+		expected *ServerVersion
+	}{
+		{"Calendar", "2020.4.0", &ServerVersion{Major: 2020, Minor: 4}},
+		{"Legacy", "3.17.14", &ServerVersion{Major: 3, Minor: 17, Patch: 14}},
+		{"Revision", "2019.12.3.1", &ServerVersion{Major: 2019, Minor: 12, Patch: 3, Revision: 1}},
+		{"PreRelease", "2020.5.0-ci0123", &ServerVersion{Major: 2020, Minor: 5, PreRelease: "ci0123"}},
+		{"BuildMetadata", "2020.5.0-ci0123+Branch.main", &ServerVersion{Major: 2020, Minor: 5, PreRelease: "ci0123"}},
+		{"MajorOnly", "2020", &ServerVersion{Major: 2020}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			serverVersion, err := ParseServerVersion(tc.version)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, serverVersion)
+		})
+	}
+
+	_, err := ParseServerVersion(emptyString)
+	require.Equal(t, createInvalidParameterError(OperationParseServerVersion, ParameterVersion), err)
+
+	for _, version := range []string{"2020.x", "1.2.3.4.5", "-1.0"} {
+		_, err = ParseServerVersion(version)
+		require.Error(t, err, version)
+	}
+}
+
+func TestServerVersionCompare(t *testing.T) {
+	parse := func(version string) *ServerVersion {
+		serverVersion, err := ParseServerVersion(version)
+		require.NoError(t, err)
+		return serverVersion
+	}
+
+	require.Equal(t, 0, parse("2020.4.0").Compare(parse("2020.4")))
+	require.Equal(t, -1, parse("2019.13.0").Compare(parse("2020.1.0")))
+	require.Equal(t, 1, parse("2020.1.10").Compare(parse("2020.1.9")))
+	require.Equal(t, -1, parse("2020.5.0-ci0123").Compare(parse("2020.5.0")))
+	require.Equal(t, 1, parse("2020.5.0").Compare(parse("2020.5.0-ci0123")))
+	require.Equal(t, -1, parse("2020.5.0-ci0123").Compare(parse("2020.5.0-ci0124")))
+
+	require.True(t, parse("2019.11.0").IsAtLeast("2019.11.0"))
+	require.False(t, parse("2019.10.5").IsAtLeast("2019.11.0"))
+	require.False(t, parse("2019.10.5").IsAtLeast("invalid"))
+
+	require.Equal(t, "2020.5.0-ci0123", parse("2020.5.0-ci0123").String())
+	require.Equal(t, "2019.12.3.1", parse("2019.12.3.1").String())
+}
+
+func createCapabilitiesClient(version string, links map[string]string) *Client {
+	c := &Client{
+		BuildInformation:   newBuildInformationService(nil, emptyString, emptyString),
+		Dashboards:         newDashboardService(nil, TestURIDashboard, links[linkDashboardDynamic]),
+		OctopusServerNodes: newOctopusServerNodeService(nil, TestURIOctopusServerNodes, links[linkOctopusServerClusterSummary]),
+		Reporting:          newReportingService(nil, "/api/Spaces-1/reporting", links[linkReportingDeploymentsCountedByWeek]),
+		Runbooks:           newRunbookService(nil, links[linkRunbooks]),
+		ScopedUserRoles:    newScopedUserRoleService(nil, links[linkScopedUserRoles]),
+		Spaces:             newSpaceService(nil, links[linkSpaces], emptyString),
+		Tenants:            newTenantService(nil, links[linkTenants], links[linkTenantsMissingVariables], emptyString, emptyString),
+		TenantVariables:    newTenantVariableService(nil, links[linkTenantVariables], links[linkTenants]),
+		WorkerPools:        newWorkerPoolService(nil, links[linkWorkerPools], emptyString, emptyString, emptyString, emptyString),
+	}
+
+	serverRoot := NewRootResource()
+	serverRoot.APIVersion = "3.0.0"
+	serverRoot.Version = version
+	c.setServerCapabilities(serverRoot, links)
+
+	return c
+}
+
+func TestClientSupports(t *testing.T) {
+	c := createCapabilitiesClient("2020.4.0", map[string]string{
+		linkRunbooks: TestURIRunbooks,
+		linkSpaces:   TestURISpaces,
+		linkTenants:  TestURITenants,
+	})
+
+	require.Equal(t, "3.0.0", c.APIVersion())
+	require.Equal(t, "2020.4.0", c.ServerVersion().String())
+	require.True(t, c.Supports(CapabilityRunbooks))
+	require.True(t, c.Supports(CapabilitySpaces))
+	require.False(t, c.Supports(CapabilityWorkerPools))
+	require.False(t, c.Supports(Capability("Unknown")))
+	require.NoError(t, c.RequireCapability(CapabilityTenants))
+
+	// the link is present but the server is older than the capability
+	c = createCapabilitiesClient("2019.9.0", map[string]string{
+		linkRunbooks: TestURIRunbooks,
+	})
+	require.False(t, c.Supports(CapabilityRunbooks))
+
+	// without a parseable version only the link relation is checked
+	c = createCapabilitiesClient("unknown", map[string]string{
+		linkRunbooks: TestURIRunbooks,
+	})
+	require.Nil(t, c.ServerVersion())
+	require.True(t, c.Supports(CapabilityRunbooks))
+}
+
+func TestUnsupportedByServerError(t *testing.T) {
+	c := createCapabilitiesClient("2018.6.0", map[string]string{
+		linkSpaces: TestURISpaces,
+	})
+
+	err := c.RequireCapability(CapabilityWorkerPools)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrUnsupportedByServer))
+	require.Contains(t, err.Error(), linkWorkerPools)
+	require.Contains(t, err.Error(), "2018.7.0")
+	require.Contains(t, err.Error(), "2018.6.0")
+
+	unsupportedByServerError, ok := err.(*UnsupportedByServerError)
+	require.True(t, ok)
+	require.Equal(t, CapabilityWorkerPools, unsupportedByServerError.Capability)
+
+	// services without a link relation report the missing capability
+	_, err = c.WorkerPools.GetAll()
+	require.True(t, errors.Is(err, ErrUnsupportedByServer))
+	require.Contains(t, err.Error(), linkWorkerPools)
+
+	_, err = c.Tenants.GetByID("Tenants-1")
+	require.True(t, errors.Is(err, ErrUnsupportedByServer))
+
+	// services whose link relation is present are unaffected
+	require.NoError(t, validateInternalState(c.Spaces))
+}
+
+func TestUnsupportedByServerErrorOperations(t *testing.T) {
+	c := createCapabilitiesClient("2020.4.0", map[string]string{
+		linkTenants: TestURITenants,
+	})
+
+	// operations without a link relation report the missing capability
+	_, err := c.Dashboards.GetDynamic(DashboardDynamicQuery{})
+	require.True(t, errors.Is(err, ErrUnsupportedByServer))
+	require.Contains(t, err.Error(), linkDashboardDynamic)
+
+	_, err = c.Reporting.GetDeploymentsCountedByWeek(DeploymentsCountedByWeekQuery{})
+	require.True(t, errors.Is(err, ErrUnsupportedByServer))
+	require.Contains(t, err.Error(), linkReportingDeploymentsCountedByWeek)
+
+	_, err = c.OctopusServerNodes.GetClusterSummary()
+	require.True(t, errors.Is(err, ErrUnsupportedByServer))
+	require.Contains(t, err.Error(), linkOctopusServerClusterSummary)
+
+	_, err = c.Tenants.GetMissingVariables(TenantsMissingVariablesQuery{})
+	require.True(t, errors.Is(err, ErrUnsupportedByServer))
+	require.Contains(t, err.Error(), linkTenantsMissingVariables)
+
+	// services created without the capabilities of a server report an
+	// invalid path
+	_, err = newDashboardService(nil, TestURIDashboard, emptyString).GetDynamic(DashboardDynamicQuery{})
+	require.Equal(t, createInvalidPathError(ServiceDashboardService), err)
+}
@@ -0,0 +1,107 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// licenseUsageWarningThreshold is the fraction of a license limit at which
+// Check reports that the limit is nearly reached.
+const licenseUsageWarningThreshold = 0.9
+
+// licenseExpiryWarningDays is the number of days before the license expires
+// from which Check reports that it is about to expire.
+const licenseExpiryWarningDays = 14
+
+// ServerCheck is the result of checking the health, status and license of an
+// Octopus server.
+type ServerCheck struct {
+	Health        *ServerHealthStatus   `json:"Health,omitempty"`
+	IsHealthy     bool                  `json:"IsHealthy"`
+	License       *LicenseStatus        `json:"License,omitempty"`
+	LimitsNearMax []*LicenseLimitStatus `json:"LimitsNearMax"`
+	Problems      []string              `json:"Problems"`
+	Status        *ServerStatus         `json:"Status,omitempty"`
+	SystemInfo    *SystemInfo           `json:"SystemInfo,omitempty"`
+}
+
+// ExitCode returns zero if the server is healthy and non-zero otherwise, for
+// use by monitoring probes.
+func (c *ServerCheck) ExitCode() int {
+	if c.IsHealthy {
+		return 0
+	}
+	return 1
+}
+
+// WriteJSON writes the check to the input writer as indented JSON.
+func (c *ServerCheck) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent(empty, tab)
+	return encoder.Encode(c)
+}
+
+// Check queries the health, status, system information and license of the
+// Octopus server. Failures to reach an endpoint are recorded as problems
+// rather than returned as errors. The server is reported as unhealthy if
+// any problem is found, including a server that is not operating normally, a
+// license that is not compliant or expires within 14 days, or a license
+// limit that is at or above 90% of its maximum.
+func (c *Client) Check() *ServerCheck {
+	check := &ServerCheck{
+		LimitsNearMax: []*LicenseLimitStatus{},
+		Problems:      []string{},
+	}
+
+	health, err := c.ServerStatus.GetHealthStatus()
+	if err != nil {
+		check.Problems = append(check.Problems, fmt.Sprintf("health check failed: %v", err))
+	} else {
+		check.Health = health
+		if !health.IsOperatingNormally {
+			check.Problems = append(check.Problems, fmt.Sprintf("server is not operating normally: %s", health.Status))
+		}
+	}
+
+	status, err := c.ServerStatus.Get()
+	if err != nil {
+		check.Problems = append(check.Problems, fmt.Sprintf("server status is unavailable: %v", err))
+	} else {
+		check.Status = status
+		if status.IsInMaintenanceMode {
+			check.Problems = append(check.Problems, "server is in maintenance mode")
+		}
+	}
+
+	systemInfo, err := c.ServerStatus.GetSystemInfo()
+	if err != nil {
+		check.Problems = append(check.Problems, fmt.Sprintf("system information is unavailable: %v", err))
+	} else {
+		check.SystemInfo = systemInfo
+	}
+
+	license, err := c.Licenses.GetCurrentStatus()
+	if err != nil {
+		check.Problems = append(check.Problems, fmt.Sprintf("license status is unavailable: %v", err))
+	} else {
+		check.License = license
+		if !license.IsCompliant {
+			check.Problems = append(check.Problems, fmt.Sprintf("license is not compliant: %s", license.ComplianceSummary))
+		}
+
+		if license.EffectiveExpiryDate != nil && license.DaysToEffectiveExpiryDate <= licenseExpiryWarningDays {
+			check.Problems = append(check.Problems, fmt.Sprintf("license expires in %d days", license.DaysToEffectiveExpiryDate))
+		}
+
+		for _, limit := range license.Limits {
+			if limit != nil && limit.IsNearLimit(licenseUsageWarningThreshold) {
+				check.LimitsNearMax = append(check.LimitsNearMax, limit)
+				check.Problems = append(check.Problems, fmt.Sprintf("license limit %s is at %d of %d", limit.Name, limit.CurrentUsage, limit.EffectiveLimit))
+			}
+		}
+	}
+
+	check.IsHealthy = len(check.Problems) == 0
+	return check
+}
@@ -0,0 +1,173 @@
+package octopusdeploy
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/dghubble/sling"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerStatusServiceNew(t *testing.T) {
+	ServiceFunction := newServerStatuService
+	client := &sling.Sling{}
+	uriTemplate := emptyString
+	extensionStatsPath := emptyString
+	healthStatusPath := emptyString
+	timezonesPath := emptyString
+	ServiceName := ServiceServerStatuService
+
+	testCases := []struct {
+		name               string
+		f                  func(*sling.Sling, string, string, string, string) *serverStatuService
+		client             *sling.Sling
+		uriTemplate        string
+		extensionStatsPath string
+		healthStatusPath   string
+		timezonesPath      string
+	}{
+		{"NilClient", ServiceFunction, nil, uriTemplate, extensionStatsPath, healthStatusPath, timezonesPath},
+		{"EmptyURITemplate", ServiceFunction, client, emptyString, extensionStatsPath, healthStatusPath, timezonesPath},
+		{"URITemplateWithWhitespace", ServiceFunction, client, whitespaceString, extensionStatsPath, healthStatusPath, timezonesPath},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := tc.f(tc.client, tc.uriTemplate, tc.extensionStatsPath, tc.healthStatusPath, tc.timezonesPath)
+			testNewService(t, service, uriTemplate, ServiceName)
+		})
+	}
+}
+
+func TestLicenseServiceNew(t *testing.T) {
+	ServiceFunction := newLicenseService
+	client := &sling.Sling{}
+	uriTemplate := emptyString
+	currentLicense := emptyString
+	currentLicenseStatus := emptyString
+	ServiceName := ServiceLicenseService
+
+	testCases := []struct {
+		name                 string
+		f                    func(*sling.Sling, string, string, string) *licenseService
+		client               *sling.Sling
+		uriTemplate          string
+		currentLicense       string
+		currentLicenseStatus string
+	}{
+		{"NilClient", ServiceFunction, nil, uriTemplate, currentLicense, currentLicenseStatus},
+		{"EmptyURITemplate", ServiceFunction, client, emptyString, currentLicense, currentLicenseStatus},
+		{"URITemplateWithWhitespace", ServiceFunction, client, whitespaceString, currentLicense, currentLicenseStatus},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := tc.f(tc.client, tc.uriTemplate, tc.currentLicense, tc.currentLicenseStatus)
+			testNewService(t, service, uriTemplate, ServiceName)
+		})
+	}
+}
+
+func createServerCheckClient(licenseStatus string, healthStatusCode int, healthStatus string) *Client {
+	client := createFakeSling(func(r *http.Request) (int, string) {
+		switch r.URL.Path {
+		case TestURIServerHealthStatus:
+			return healthStatusCode, healthStatus
+		case TestURIServerStatus:
+			return http.StatusOK, `{"Id":"ServerStatus","IsDatabaseEncrypted":true,"IsInMaintenanceMode":false}`
+		case TestURIServerStatus + "/system-info":
+			return http.StatusOK, `{"Version":"2020.4.0","OSVersion":"Microsoft Windows NT 10.0","Uptime":"12.03:14:00","WorkingSetBytes":734003200,"ThreadCount":64}`
+		case TestURIExtensionStats:
+			return http.StatusOK, `[{"ExtensionName":"Octopus.Server.Extensibility.Authentication.Guest","Stats":[{"ActionName":"Login","Count":3,"TotalMilliseconds":120}]}]`
+		case TestURITimezones:
+			return http.StatusOK, `[{"Id":"UTC","Name":"(UTC) Coordinated Universal Time"}]`
+		case TestURICurrentLicenseStatus:
+			return http.StatusOK, licenseStatus
+		}
+		return http.StatusNotFound, `{}`
+	})
+
+	return &Client{
+		Licenses:     newLicenseService(client, "/api/licenses", TestURICurrentLicense, TestURICurrentLicenseStatus),
+		ServerStatus: newServerStatuService(client, TestURIServerStatus, TestURIExtensionStats, TestURIServerHealthStatus, TestURITimezones),
+	}
+}
+
+func TestServerStatusServiceGet(t *testing.T) {
+	c := createServerCheckClient(`{}`, http.StatusOK, `{"IsOperatingNormally":true}`)
+
+	status, err := c.ServerStatus.Get()
+	require.NoError(t, err)
+	require.True(t, status.IsDatabaseEncrypted)
+
+	systemInfo, err := c.ServerStatus.GetSystemInfo()
+	require.NoError(t, err)
+	require.Equal(t, "2020.4.0", systemInfo.Version)
+	require.Equal(t, int64(734003200), systemInfo.WorkingSetBytes)
+
+	extensionStats, err := c.ServerStatus.GetExtensionStats()
+	require.NoError(t, err)
+	require.Len(t, extensionStats, 1)
+	require.Equal(t, 3, extensionStats[0].Stats[0].Count)
+
+	timezones, err := c.ServerStatus.GetTimezones()
+	require.NoError(t, err)
+	require.Len(t, timezones, 1)
+	require.Equal(t, "UTC", timezones[0].ID)
+
+	service := newServerStatuService(nil, TestURIServerStatus, emptyString, emptyString, emptyString)
+	_, err = service.GetHealthStatus()
+	require.Equal(t, createInvalidPathError(ServiceServerStatuService), err)
+	_, err = service.GetTimezones()
+	require.Equal(t, createInvalidPathError(ServiceServerStatuService), err)
+
+	licenses := newLicenseService(nil, "/api/licenses", emptyString, emptyString)
+	_, err = licenses.GetCurrentStatus()
+	require.Equal(t, createInvalidPathError(ServiceLicenseService), err)
+}
+
+func TestLicenseLimitStatusIsNearLimit(t *testing.T) {
+	require.True(t, (&LicenseLimitStatus{CurrentUsage: 9, EffectiveLimit: 10}).IsNearLimit(0.9))
+	require.False(t, (&LicenseLimitStatus{CurrentUsage: 8, EffectiveLimit: 10}).IsNearLimit(0.9))
+	require.False(t, (&LicenseLimitStatus{CurrentUsage: 1000, IsUnlimited: true}).IsNearLimit(0.9))
+	require.True(t, (&LicenseLimitStatus{CurrentUsage: 1}).IsNearLimit(0.9))
+	require.Equal(t, 0.5, (&LicenseLimitStatus{CurrentUsage: 5, EffectiveLimit: 10}).GetUsage())
+}
+
+func TestCheck(t *testing.T) {
+	c := createServerCheckClient(`{"IsCompliant":true,"Limits":[{"Name":"Projects","CurrentUsage":5,"EffectiveLimit":10},{"Name":"Machines","CurrentUsage":4,"IsUnlimited":true}]}`, http.StatusOK, `{"IsOperatingNormally":true}`)
+
+	check := c.Check()
+	require.True(t, check.IsHealthy)
+	require.Equal(t, 0, check.ExitCode())
+	require.Empty(t, check.Problems)
+	require.NotNil(t, check.SystemInfo)
+
+	c = createServerCheckClient(`{"IsCompliant":true,"Limits":[{"Name":"Projects","CurrentUsage":19,"EffectiveLimit":20}]}`, http.StatusOK, `{"IsOperatingNormally":true}`)
+
+	check = c.Check()
+	require.False(t, check.IsHealthy)
+	require.Equal(t, 1, check.ExitCode())
+	require.Len(t, check.LimitsNearMax, 1)
+	require.Equal(t, "Projects", check.LimitsNearMax[0].Name)
+
+	c = createServerCheckClient(`{"IsCompliant":false,"ComplianceSummary":"expired"}`, http.StatusServiceUnavailable, `{}`)
+
+	check = c.Check()
+	require.False(t, check.IsHealthy)
+	require.Len(t, check.Problems, 2)
+	require.Nil(t, check.Health)
+
+	c = createServerCheckClient(`{"IsCompliant":true,"Limits":[]}`, http.StatusOK, `{"IsOperatingNormally":false,"Status":"Database is unreachable"}`)
+
+	check = c.Check()
+	require.False(t, check.IsHealthy)
+	require.Equal(t, []string{"server is not operating normally: Database is unreachable"}, check.Problems)
+
+	c = createServerCheckClient(`{"IsCompliant":true,"DaysToEffectiveExpiryDate":6,"EffectiveExpiryDate":"2026-10-25T00:00:00Z","Limits":[]}`, http.StatusOK, `{"IsOperatingNormally":true}`)
+
+	check = c.Check()
+	require.False(t, check.IsHealthy)
+	require.Equal(t, []string{"license expires in 6 days"}, check.Problems)
+
+	c = createServerCheckClient(`{"IsCompliant":true,"DaysToEffectiveExpiryDate":200,"EffectiveExpiryDate":"2027-05-07T00:00:00Z","Limits":[]}`, http.StatusOK, `{"IsOperatingNormally":true}`)
+	require.True(t, c.Check().IsHealthy)
+}
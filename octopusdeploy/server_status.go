@@ -0,0 +1,59 @@
+package octopusdeploy
+
+import "time"
+
+// ServerStatus represents the status of an Octopus server.
+type ServerStatus struct {
+	IsDatabaseEncrypted                     bool       `json:"IsDatabaseEncrypted"`
+	IsInMaintenanceMode                     bool       `json:"IsInMaintenanceMode"`
+	IsMajorMinorUpgrade                     bool       `json:"IsMajorMinorUpgrade"`
+	IsUpgradeAvailable                      bool       `json:"IsUpgradeAvailable"`
+	MaintenanceExpires                      *time.Time `json:"MaintenanceExpires,omitempty"`
+	MaximumAvailableVersion                 string     `json:"MaximumAvailableVersion,omitempty"`
+	MaximumAvailableVersionCoveredByLicense string     `json:"MaximumAvailableVersionCoveredByLicense,omitempty"`
+
+	resource
+}
+
+// SystemInfo represents details of the host and process of an Octopus server.
+type SystemInfo struct {
+	ClrVersion        string `json:"ClrVersion,omitempty"`
+	DatabaseName      string `json:"DatabaseName,omitempty"`
+	DatabaseServer    string `json:"DatabaseServer,omitempty"`
+	ExecutingAssembly string `json:"ExecutingAssembly,omitempty"`
+	OSVersion         string `json:"OSVersion,omitempty"`
+	ThreadCount       int    `json:"ThreadCount"`
+	Uptime            string `json:"Uptime,omitempty"`
+	Version           string `json:"Version,omitempty"`
+	WorkingSetBytes   int64  `json:"WorkingSetBytes"`
+
+	resource
+}
+
+// ServerHealthStatus represents the result of the health check of an Octopus
+// server.
+type ServerHealthStatus struct {
+	IsOperatingNormally bool   `json:"IsOperatingNormally"`
+	Status              string `json:"Status,omitempty"`
+}
+
+// ExtensionStats represents the usage statistics recorded by a server
+// extension.
+type ExtensionStats struct {
+	ExtensionName string                 `json:"ExtensionName"`
+	Stats         []*ExtensionStatsValue `json:"Stats"`
+}
+
+// ExtensionStatsValue represents a single statistic recorded by a server
+// extension.
+type ExtensionStatsValue struct {
+	ActionName        string `json:"ActionName"`
+	Count             int    `json:"Count"`
+	TotalMilliseconds int64  `json:"TotalMilliseconds"`
+}
+
+// Timezone represents a timezone supported by an Octopus server.
+type Timezone struct {
+	ID   string `json:"Id"`
+	Name string `json:"Name"`
+}
@@ -18,3 +18,73 @@ func newServerStatuService(sling *sling.Sling, uriTemplate string, extensionStat
 		service:            newService(ServiceServerStatuService, sling, uriTemplate),
 	}
 }
+
+// Get returns the status of the Octopus server.
+func (s serverStatuService) Get() (*ServerStatus, error) {
+	path, err := getPath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiGet(s.getClient(), new(ServerStatus), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*ServerStatus), nil
+}
+
+// GetExtensionStats returns the usage statistics recorded by the extensions
+// of the Octopus server.
+func (s serverStatuService) GetExtensionStats() ([]*ExtensionStats, error) {
+	items := []*ExtensionStats{}
+	if isEmpty(s.extensionStatsPath) {
+		return items, createInvalidPathError(s.getName())
+	}
+
+	_, err := apiGet(s.getClient(), &items, s.extensionStatsPath)
+	return items, err
+}
+
+// GetHealthStatus returns the result of the health check of the Octopus
+// server. A server that does not respond with a success status code is
+// reported as an error.
+func (s serverStatuService) GetHealthStatus() (*ServerHealthStatus, error) {
+	if isEmpty(s.healthStatusPath) {
+		return nil, createInvalidPathError(s.getName())
+	}
+
+	resp, err := apiGet(s.getClient(), new(ServerHealthStatus), s.healthStatusPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*ServerHealthStatus), nil
+}
+
+// GetSystemInfo returns details of the host and process of the Octopus
+// server, such as its version, operating system, uptime and memory usage.
+func (s serverStatuService) GetSystemInfo() (*SystemInfo, error) {
+	path, err := getPath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiGet(s.getClient(), new(SystemInfo), path+"/system-info")
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*SystemInfo), nil
+}
+
+// GetTimezones returns the timezones supported by the Octopus server.
+func (s serverStatuService) GetTimezones() ([]*Timezone, error) {
+	items := []*Timezone{}
+	if isEmpty(s.timezonesPath) {
+		return items, createInvalidPathError(s.getName())
+	}
+
+	_, err := apiGet(s.getClient(), &items, s.timezonesPath)
+	return items, err
+}
@@ -0,0 +1,101 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ServerVersion represents the version of an Octopus server, such as
+// 2020.4.0 or 2020.5.0-ci0123.
+type ServerVersion struct {
+	Major      int
+	Minor      int
+	Patch      int
+	PreRelease string
+	Revision   int
+}
+
+// ParseServerVersion parses a version reported by an Octopus server. Missing
+// minor, patch and revision components are treated as zero.
+func ParseServerVersion(version string) (*ServerVersion, error) {
+	version = strings.TrimSpace(version)
+	if isEmpty(version) {
+		return nil, createInvalidParameterError(OperationParseServerVersion, ParameterVersion)
+	}
+
+	serverVersion := &ServerVersion{}
+
+	if i := strings.IndexAny(version, "-+"); i >= 0 {
+		if version[i] == '-' {
+			serverVersion.PreRelease = strings.SplitN(version[i+1:], "+", 2)[0]
+		}
+		version = version[:i]
+	}
+
+	components := strings.Split(version, ".")
+	if len(components) > 4 {
+		return nil, fmt.Errorf("the server version (%s) has too many components", version)
+	}
+
+	values := []*int{&serverVersion.Major, &serverVersion.Minor, &serverVersion.Patch, &serverVersion.Revision}
+	for i, component := range components {
+		value, err := strconv.Atoi(component)
+		if err != nil || value < 0 {
+			return nil, fmt.Errorf("the server version (%s) is not valid", version)
+		}
+		*values[i] = value
+	}
+
+	return serverVersion, nil
+}
+
+// Compare returns -1, 0 or 1 if the version is lower than, equal to or
+// higher than the input version. A pre-release version is lower than the
+// corresponding release.
+func (v *ServerVersion) Compare(other *ServerVersion) int {
+	left := []int{v.Major, v.Minor, v.Patch, v.Revision}
+	right := []int{other.Major, other.Minor, other.Patch, other.Revision}
+	for i := range left {
+		if left[i] < right[i] {
+			return -1
+		}
+		if left[i] > right[i] {
+			return 1
+		}
+	}
+
+	switch {
+	case v.PreRelease == other.PreRelease:
+		return 0
+	case isEmpty(v.PreRelease):
+		return 1
+	case isEmpty(other.PreRelease):
+		return -1
+	case v.PreRelease < other.PreRelease:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// IsAtLeast returns true if the version is equal to or higher than the input
+// version. It returns false if the input version cannot be parsed.
+func (v *ServerVersion) IsAtLeast(version string) bool {
+	minimum, err := ParseServerVersion(version)
+	if err != nil {
+		return false
+	}
+	return v.Compare(minimum) >= 0
+}
+
+func (v *ServerVersion) String() string {
+	version := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Revision > 0 {
+		version += fmt.Sprintf(".%d", v.Revision)
+	}
+	if !isEmpty(v.PreRelease) {
+		version += "-" + v.PreRelease
+	}
+	return version
+}
@@ -19,6 +19,7 @@ type IService interface {
 	getClient() *sling.Sling
 	getName() string
 	getPath() string
+	getUnsupportedError() error
 	getURITemplate() *uritemplates.UriTemplate
 }
 
@@ -29,6 +30,10 @@ type service struct {
 	Sling       *sling.Sling
 	URITemplate *uritemplates.UriTemplate
 	itemType    IResource
+
+	// unsupportedError is set when the server does not advertise the link
+	// relation of the service.
+	unsupportedError error
 }
 
 type canDeleteService struct {
@@ -68,6 +73,19 @@ func (s service) getPath() string {
 	return s.Path
 }
 
+func (s service) getUnsupportedError() error {
+	return s.unsupportedError
+}
+
+// getPathError returns the unsupported error of a path of the service if the
+// server does not advertise its link relation, or an invalid path error.
+func (s service) getPathError(unsupportedError error) error {
+	if unsupportedError != nil {
+		return unsupportedError
+	}
+	return createInvalidPathError(s.getName())
+}
+
 func (s service) getURITemplate() *uritemplates.UriTemplate {
 	return s.URITemplate
 }
@@ -245,6 +263,9 @@ func validateInternalState(s IService) error {
 	path, err := s.getURITemplate().Expand(values)
 
 	if isEmpty(path) {
+		if unsupportedError := s.getUnsupportedError(); unsupportedError != nil {
+			return unsupportedError
+		}
 		return createInvalidPathError(s.getName())
 	}
 
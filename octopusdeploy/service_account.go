@@ -0,0 +1,152 @@
+package octopusdeploy
+
+import "strings"
+
+// ServiceAccountProvisioning is the result of provisioning a service account.
+type ServiceAccountProvisioning struct {
+	// APIKey is the API key created for the service account. It is nil if an
+	// API key with the requested purpose already exists, since the value of
+	// an existing key cannot be retrieved.
+	APIKey *APIKey
+
+	// Created is true if the service account did not exist before.
+	Created bool
+
+	// ExistingAPIKey is the existing API key with the requested purpose, if
+	// any.
+	ExistingAPIKey *APIKey
+
+	// JoinedTeams are the teams the service account was added to.
+	JoinedTeams []*Team
+
+	// User is the service account.
+	User *User
+}
+
+// ProvisionServiceAccount ensures that a service account with the input
+// name exists, is a member of the named teams and has an API key with the
+// input purpose. It is idempotent by username: an existing service account
+// is reused, teams it already belongs to are left unchanged and an API key
+// is only created if none with the same purpose exists. An empty purpose
+// skips API key creation. An error is returned if a user with the same
+// username exists but is not a service account, or if a team cannot be
+// found.
+func (c *Client) ProvisionServiceAccount(name string, teams []string, apiKeyPurpose string) (*ServiceAccountProvisioning, error) {
+	if isEmpty(name) {
+		return nil, createInvalidParameterError(OperationProvisionServiceAccount, ParameterName)
+	}
+
+	resolvedTeams := []*Team{}
+	for _, teamName := range teams {
+		team, err := c.getTeamByName(teamName)
+		if err != nil {
+			return nil, err
+		}
+		resolvedTeams = append(resolvedTeams, team)
+	}
+
+	provisioning := &ServiceAccountProvisioning{
+		JoinedTeams: []*Team{},
+	}
+
+	user, err := c.getUserByUsername(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		serviceAccount := NewUser(name, name)
+		serviceAccount.IsActive = true
+		serviceAccount.IsService = true
+
+		user, err = c.Users.Add(serviceAccount)
+		if err != nil {
+			return nil, err
+		}
+		provisioning.Created = true
+	} else if !user.IsService {
+		return nil, createUserIsNotServiceAccountError(OperationProvisionServiceAccount, name)
+	}
+	provisioning.User = user
+
+	for _, team := range resolvedTeams {
+		if ValidateStringInSlice(user.GetID(), team.MemberUserIDs) {
+			continue
+		}
+
+		team.MemberUserIDs = append(team.MemberUserIDs, user.GetID())
+		updatedTeam, err := c.Teams.Update(team)
+		if err != nil {
+			return provisioning, err
+		}
+		provisioning.JoinedTeams = append(provisioning.JoinedTeams, updatedTeam)
+	}
+
+	if isEmpty(apiKeyPurpose) {
+		return provisioning, nil
+	}
+
+	apiKeys, err := c.APIKeys.GetByUserID(user.GetID())
+	if err != nil {
+		return provisioning, err
+	}
+
+	for _, apiKey := range apiKeys {
+		if apiKey.Purpose == apiKeyPurpose {
+			provisioning.ExistingAPIKey = apiKey
+			return provisioning, nil
+		}
+	}
+
+	provisioning.APIKey, err = c.APIKeys.Create(NewAPIKey(apiKeyPurpose, user.GetID()))
+	if err != nil {
+		return provisioning, err
+	}
+
+	return provisioning, nil
+}
+
+// getUserByUsername returns the user with the input username, or nil if no
+// such user exists. Usernames are compared without regard to case.
+func (c *Client) getUserByUsername(username string) (*User, error) {
+	users, err := c.Users.Get(UsersQuery{Filter: username})
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		for _, user := range users.Items {
+			if strings.EqualFold(user.Username, username) {
+				return user, nil
+			}
+		}
+
+		path, loadNextPage := LoadNextPage(users.PagedResults)
+		if !loadNextPage {
+			return nil, nil
+		}
+
+		resp, err := apiGet(c.Users.getClient(), new(Users), path)
+		if err != nil {
+			return nil, err
+		}
+		users = resp.(*Users)
+	}
+}
+
+// getTeamByName returns the team with the input name. Names are compared
+// without regard to case.
+func (c *Client) getTeamByName(name string) (*Team, error) {
+	teams, err := c.Teams.GetByPartialName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, team := range teams {
+		if strings.EqualFold(team.Name, name) {
+			return team, nil
+		}
+	}
+
+	return nil, createResourceNotFoundError(ServiceTeamService, "name", name)
+}
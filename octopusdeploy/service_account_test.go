@@ -0,0 +1,160 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeUserDirectory answers user, team and API key requests from memory and
+// records every change made through it.
+type fakeUserDirectory struct {
+	t *testing.T
+
+	apiKeys      []*APIKey
+	createdKeys  []*APIKey
+	teams        []*Team
+	updatedTeams []string
+	updatedUsers []string
+	users        []*User
+}
+
+func (f *fakeUserDirectory) handle(r *http.Request) (int, string) {
+	marshal := func(v interface{}) string {
+		data, err := json.Marshal(v)
+		require.NoError(f.t, err)
+		return string(data)
+	}
+
+	unmarshal := func(v interface{}) {
+		data, err := ioutil.ReadAll(r.Body)
+		require.NoError(f.t, err)
+		require.NoError(f.t, json.Unmarshal(data, v))
+	}
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/users":
+		users := &Users{Items: []*User{}}
+		for _, user := range f.users {
+			if strings.Contains(strings.ToLower(user.Username), strings.ToLower(r.URL.Query().Get("filter"))) {
+				users.Items = append(users.Items, user)
+			}
+		}
+		return http.StatusOK, marshal(users)
+	case r.Method == http.MethodGet && r.URL.Path == "/api/users/all":
+		return http.StatusOK, marshal(f.users)
+	case r.Method == http.MethodPost && r.URL.Path == "/api/users":
+		user := new(User)
+		unmarshal(user)
+		user.ID = fmt.Sprintf("Users-%d", len(f.users)+1)
+		f.users = append(f.users, user)
+		return http.StatusCreated, marshal(user)
+	case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/api/users/"):
+		user := new(User)
+		unmarshal(user)
+		f.updatedUsers = append(f.updatedUsers, user.GetID())
+		return http.StatusOK, marshal(user)
+	case r.Method == http.MethodGet && r.URL.Path == "/api/users/Users-1/apikeys":
+		return http.StatusOK, marshal(&APIKeys{Items: f.apiKeys})
+	case r.Method == http.MethodPost && r.URL.Path == "/api/users/Users-1/apikeys":
+		apiKey := new(APIKey)
+		unmarshal(apiKey)
+		apiKey.ID = "APIKeys-9"
+		apiKey.APIKey = "API-NEWKEY"
+		f.createdKeys = append(f.createdKeys, apiKey)
+		return http.StatusCreated, marshal(apiKey)
+	case r.Method == http.MethodGet && r.URL.Path == "/api/teams":
+		teams := &Teams{Items: []*Team{}}
+		for _, team := range f.teams {
+			if strings.Contains(strings.ToLower(team.Name), strings.ToLower(r.URL.Query().Get("partialName"))) {
+				teams.Items = append(teams.Items, team)
+			}
+		}
+		return http.StatusOK, marshal(teams)
+	case r.Method == http.MethodGet && r.URL.Path == "/api/teams/all":
+		return http.StatusOK, marshal(f.teams)
+	case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/api/teams/"):
+		team := new(Team)
+		unmarshal(team)
+		f.updatedTeams = append(f.updatedTeams, team.GetID())
+		for i := range f.teams {
+			if f.teams[i].GetID() == team.GetID() {
+				f.teams[i] = team
+			}
+		}
+		return http.StatusOK, marshal(team)
+	}
+	return http.StatusNotFound, `{"ErrorMessage":"not found"}`
+}
+
+func (f *fakeUserDirectory) client() *Client {
+	base := createFakeSling(f.handle)
+	return &Client{
+		APIKeys: newAPIKeyService(base, TestURIUsers),
+		Teams:   newTeamService(base, TestURITeams),
+		Users:   newUserService(base, TestURIUsers, emptyString, emptyString, emptyString, emptyString, emptyString, emptyString, emptyString, emptyString, emptyString),
+	}
+}
+
+func newFakeUserDirectory(t *testing.T) *fakeUserDirectory {
+	return &fakeUserDirectory{
+		t:       t,
+		apiKeys: []*APIKey{},
+		teams: []*Team{
+			{Name: "Deployers", MemberUserIDs: []string{}, resource: resource{ID: "Teams-1"}},
+			{Name: "Deployers (Legacy)", MemberUserIDs: []string{}, resource: resource{ID: "Teams-2"}},
+			{Name: "Operators", MemberUserIDs: []string{}, resource: resource{ID: "Teams-3"}},
+		},
+		users: []*User{},
+	}
+}
+
+func TestProvisionServiceAccount(t *testing.T) {
+	c := &Client{}
+	_, err := c.ProvisionServiceAccount(emptyString, nil, "ci")
+	require.Equal(t, createInvalidParameterError(OperationProvisionServiceAccount, ParameterName), err)
+
+	directory := newFakeUserDirectory(t)
+	client := directory.client()
+
+	provisioning, err := client.ProvisionServiceAccount("deployer", []string{"deployers"}, "ci")
+	require.NoError(t, err)
+	require.True(t, provisioning.Created)
+	require.Equal(t, "Users-1", provisioning.User.GetID())
+	require.True(t, directory.users[0].IsService)
+	require.Len(t, provisioning.JoinedTeams, 1)
+	require.Equal(t, "Teams-1", provisioning.JoinedTeams[0].GetID())
+	require.Equal(t, []string{"Teams-1"}, directory.updatedTeams)
+	require.Equal(t, "API-NEWKEY", provisioning.APIKey.APIKey)
+	require.Nil(t, provisioning.ExistingAPIKey)
+
+	// provisioning again changes nothing
+	directory.apiKeys = directory.createdKeys
+	provisioning, err = client.ProvisionServiceAccount("Deployer", []string{"Deployers"}, "ci")
+	require.NoError(t, err)
+	require.False(t, provisioning.Created)
+	require.Empty(t, provisioning.JoinedTeams)
+	require.Nil(t, provisioning.APIKey)
+	require.Equal(t, "APIKeys-9", provisioning.ExistingAPIKey.GetID())
+	require.Len(t, directory.users, 1)
+	require.Len(t, directory.createdKeys, 1)
+	require.Equal(t, []string{"Teams-1"}, directory.updatedTeams)
+}
+
+func TestProvisionServiceAccountErrors(t *testing.T) {
+	directory := newFakeUserDirectory(t)
+	client := directory.client()
+
+	_, err := client.ProvisionServiceAccount("deployer", []string{"Missing"}, "ci")
+	require.Equal(t, createResourceNotFoundError(ServiceTeamService, "name", "Missing"), err)
+	require.Empty(t, directory.users)
+
+	directory.users = []*User{{Username: "deployer", resource: resource{ID: "Users-1"}}}
+	_, err = client.ProvisionServiceAccount("deployer", nil, "ci")
+	require.Equal(t, createUserIsNotServiceAccountError(OperationProvisionServiceAccount, "deployer"), err)
+}
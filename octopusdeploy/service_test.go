@@ -2,11 +2,15 @@ package octopusdeploy
 
 import (
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
 	"net/url"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/dghubble/sling"
 	"github.com/fqjony/go-octopusdeploy/uritemplates"
 	"github.com/stretchr/testify/require"
 )
@@ -57,3 +61,21 @@ func testNewService(t *testing.T, service IService, uriTemplate string, ServiceN
 	require.Equal(t, service.getURITemplate(), template)
 	require.Equal(t, service.getName(), ServiceName)
 }
+
+// createFakeSling returns a client that never leaves the process; every
+// request is answered by the input handler with a status code and JSON body.
+func createFakeSling(handler func(r *http.Request) (int, string)) *sling.Sling {
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			statusCode, body := handler(r)
+			return &http.Response{
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Request:    r,
+				StatusCode: statusCode,
+			}, nil
+		}),
+	}
+
+	return sling.New().Client(httpClient).Base("http://octopus.test")
+}
@@ -0,0 +1,40 @@
+package octopusdeploy
+
+import "github.com/go-playground/validator/v10"
+
+// SMTPConfiguration represents the SMTP settings the Octopus server uses to
+// send email.
+type SMTPConfiguration struct {
+	EnableSSL     bool            `json:"EnableSsl"`
+	SendEmailFrom string          `json:"SendEmailFrom,omitempty" validate:"omitempty,email"`
+	SMTPHost      string          `json:"SmtpHost,omitempty"`
+	SMTPLogin     string          `json:"SmtpLogin,omitempty"`
+	SMTPPassword  *SensitiveValue `json:"SmtpPassword,omitempty"`
+	SMTPPort      int             `json:"SmtpPort,omitempty" validate:"gte=0,lte=65535"`
+	Timeout       int             `json:"Timeout,omitempty" validate:"gte=0"`
+
+	resource
+}
+
+// SMTPIsConfigured indicates whether the SMTP settings of the Octopus server
+// have been configured.
+type SMTPIsConfigured struct {
+	IsConfigured bool `json:"IsConfigured"`
+}
+
+// NewSMTPConfiguration initializes SMTP settings with a host, port and sender
+// address.
+func NewSMTPConfiguration(host string, port int, sendEmailFrom string) *SMTPConfiguration {
+	return &SMTPConfiguration{
+		SendEmailFrom: sendEmailFrom,
+		SMTPHost:      host,
+		SMTPPort:      port,
+		resource:      *newResource(),
+	}
+}
+
+// Validate checks the state of the SMTP configuration and returns an error if
+// invalid.
+func (s *SMTPConfiguration) Validate() error {
+	return validator.New().Struct(s)
+}
@@ -14,3 +14,53 @@ func newSMTPConfigurationService(sling *sling.Sling, uriTemplate string, isConfi
 		service:          newService(ServiceSMTPConfigurationService, sling, uriTemplate),
 	}
 }
+
+// Get returns the SMTP configuration of the Octopus server.
+func (s smtpConfigurationService) Get() (*SMTPConfiguration, error) {
+	path, err := getPath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiGet(s.getClient(), new(SMTPConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*SMTPConfiguration), nil
+}
+
+// IsConfigured returns true if the SMTP settings of the Octopus server have
+// been configured.
+func (s smtpConfigurationService) IsConfigured() (bool, error) {
+	if isEmpty(s.isConfiguredPath) {
+		return false, createInvalidPathError(s.getName())
+	}
+
+	resp, err := apiGet(s.getClient(), new(SMTPIsConfigured), s.isConfiguredPath)
+	if err != nil {
+		return false, err
+	}
+
+	return resp.(*SMTPIsConfigured).IsConfigured, nil
+}
+
+// Update modifies the SMTP configuration of the Octopus server based on the
+// one provided as input.
+func (s smtpConfigurationService) Update(smtpConfiguration *SMTPConfiguration) (*SMTPConfiguration, error) {
+	if smtpConfiguration == nil {
+		return nil, createInvalidParameterError(OperationUpdate, ParameterSMTPConfiguration)
+	}
+
+	path, err := getUpdatePath(s, smtpConfiguration)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiUpdate(s.getClient(), smtpConfiguration, new(SMTPConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*SMTPConfiguration), nil
+}
@@ -0,0 +1,68 @@
+package octopusdeploy
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/dghubble/sling"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSMTPConfigurationServiceNew(t *testing.T) {
+	ServiceFunction := newSMTPConfigurationService
+	client := &sling.Sling{}
+	uriTemplate := emptyString
+	isConfiguredPath := emptyString
+	ServiceName := ServiceSMTPConfigurationService
+
+	testCases := []struct {
+		name             string
+		f                func(*sling.Sling, string, string) *smtpConfigurationService
+		client           *sling.Sling
+		uriTemplate      string
+		isConfiguredPath string
+	}{
+		{"NilClient", ServiceFunction, nil, uriTemplate, isConfiguredPath},
+		{"EmptyURITemplate", ServiceFunction, client, emptyString, isConfiguredPath},
+		{"URITemplateWithWhitespace", ServiceFunction, client, whitespaceString, isConfiguredPath},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := tc.f(tc.client, tc.uriTemplate, tc.isConfiguredPath)
+			testNewService(t, service, uriTemplate, ServiceName)
+		})
+	}
+}
+
+func TestSMTPConfigurationServiceGetAndUpdate(t *testing.T) {
+	client := createFakeSling(func(r *http.Request) (int, string) {
+		switch r.URL.Path {
+		case TestURISmtpConfiguration:
+			return http.StatusOK, `{"Id":"smtp","SmtpHost":"smtp.example.com","SmtpPort":587,"EnableSsl":true,"SmtpPassword":{"HasValue":true}}`
+		case TestURISmtpIsConfigured:
+			return http.StatusOK, `{"IsConfigured":true}`
+		}
+		return http.StatusNotFound, `{}`
+	})
+	service := newSMTPConfigurationService(client, TestURISmtpConfiguration, TestURISmtpIsConfigured)
+
+	smtpConfiguration, err := service.Get()
+	require.NoError(t, err)
+	require.Equal(t, "smtp.example.com", smtpConfiguration.SMTPHost)
+	require.Equal(t, 587, smtpConfiguration.SMTPPort)
+	require.True(t, smtpConfiguration.EnableSSL)
+	require.True(t, smtpConfiguration.SMTPPassword.HasValue)
+
+	isConfigured, err := service.IsConfigured()
+	require.NoError(t, err)
+	require.True(t, isConfigured)
+
+	resource, err := service.Update(nil)
+	require.Equal(t, createInvalidParameterError(OperationUpdate, ParameterSMTPConfiguration), err)
+	require.Nil(t, resource)
+
+	smtpConfiguration.SendEmailFrom = "not an email address"
+	resource, err = service.Update(smtpConfiguration)
+	require.Error(t, err)
+	require.Nil(t, resource)
+}
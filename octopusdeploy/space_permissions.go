@@ -1,5 +1,7 @@
 package octopusdeploy
 
+import "reflect"
+
 type SpacePermissions struct {
 	AccountCreate                     []UserPermissionRestriction `json:"AccountCreate"`
 	AccountDelete                     []UserPermissionRestriction `json:"AccountDelete"`
@@ -118,3 +120,16 @@ type SpacePermissions struct {
 	WorkerEdit                        []UserPermissionRestriction `json:"WorkerEdit"`
 	WorkerView                        []UserPermissionRestriction `json:"WorkerView"`
 }
+
+// GetRestrictions returns the restrictions under which the input space
+// permission is granted. It returns nil if the permission is not granted or
+// is not a known space permission.
+func (p SpacePermissions) GetRestrictions(permission string) []UserPermissionRestriction {
+	field := reflect.ValueOf(p).FieldByName(permission)
+	if !field.IsValid() {
+		return nil
+	}
+
+	restrictions, _ := field.Interface().([]UserPermissionRestriction)
+	return restrictions
+}
@@ -0,0 +1,37 @@
+package octopusdeploy
+
+import "time"
+
+// Tasks defines a collection of tasks with built-in support for paged
+// results.
+type Tasks struct {
+	Items []*Task `json:"Items"`
+	PagedResults
+}
+
+// Task represents a server task such as a deployment, runbook run, or health
+// check.
+type Task struct {
+	Arguments                  map[string]interface{} `json:"Arguments,omitempty"`
+	CanRerun                   bool                   `json:"CanRerun,omitempty"`
+	Completed                  string                 `json:"Completed,omitempty"`
+	CompletedTime              *time.Time             `json:"CompletedTime,omitempty"`
+	Description                string                 `json:"Description,omitempty"`
+	Duration                   string                 `json:"Duration,omitempty"`
+	ErrorMessage               string                 `json:"ErrorMessage,omitempty"`
+	FinishedSuccessfully       bool                   `json:"FinishedSuccessfully,omitempty"`
+	HasBeenPickedUpByProcessor bool                   `json:"HasBeenPickedUpByProcessor,omitempty"`
+	HasPendingInterruptions    bool                   `json:"HasPendingInterruptions,omitempty"`
+	HasWarningsOrErrors        bool                   `json:"HasWarningsOrErrors,omitempty"`
+	IsCompleted                bool                   `json:"IsCompleted,omitempty"`
+	LastUpdatedTime            *time.Time             `json:"LastUpdatedTime,omitempty"`
+	Name                       string                 `json:"Name,omitempty"`
+	QueueTime                  *time.Time             `json:"QueueTime,omitempty"`
+	QueueTimeExpiry            *time.Time             `json:"QueueTimeExpiry,omitempty"`
+	ServerNode                 string                 `json:"ServerNode,omitempty"`
+	SpaceID                    string                 `json:"SpaceId,omitempty"`
+	StartTime                  *time.Time             `json:"StartTime,omitempty"`
+	State                      TaskState              `json:"State,omitempty"`
+
+	resource
+}
@@ -1,6 +1,9 @@
 package octopusdeploy
 
-import "github.com/dghubble/sling"
+import (
+	"github.com/dghubble/sling"
+	"github.com/google/go-querystring/query"
+)
 
 type taskService struct {
 	taskTypesPath string
@@ -14,3 +17,95 @@ func newTaskService(sling *sling.Sling, uriTemplate string, taskTypesPath string
 		service:       newService(ServiceTaskService, sling, uriTemplate),
 	}
 }
+
+func (s taskService) getPagedResponse(path string) ([]*Task, error) {
+	resources := []*Task{}
+	loadNextPage := true
+
+	for loadNextPage {
+		resp, err := apiGet(s.getClient(), new(Tasks), path)
+		if err != nil {
+			return resources, err
+		}
+
+		responseList := resp.(*Tasks)
+		resources = append(resources, responseList.Items...)
+		path, loadNextPage = LoadNextPage(responseList.PagedResults)
+	}
+
+	return resources, nil
+}
+
+// Get returns a collection of tasks based on the criteria defined by its input
+// query parameter. If an error occurs, an empty collection is returned along
+// with the associated error.
+func (s taskService) Get(tasksQuery TasksQuery) (*Tasks, error) {
+	err := validateInternalState(s)
+	if err != nil {
+		return &Tasks{}, err
+	}
+
+	v, _ := query.Values(tasksQuery)
+	path := s.BasePath
+	encodedQueryString := v.Encode()
+	if len(encodedQueryString) > 0 {
+		path += "?" + encodedQueryString
+	}
+
+	resp, err := apiGet(s.getClient(), new(Tasks), path)
+	if err != nil {
+		return &Tasks{}, err
+	}
+
+	return resp.(*Tasks), nil
+}
+
+// GetAll returns every task that matches the input query parameter, following
+// paged results until the last page is loaded.
+func (s taskService) GetAll(tasksQuery TasksQuery) ([]*Task, error) {
+	err := validateInternalState(s)
+	if err != nil {
+		return []*Task{}, err
+	}
+
+	v, _ := query.Values(tasksQuery)
+	path := s.BasePath
+	encodedQueryString := v.Encode()
+	if len(encodedQueryString) > 0 {
+		path += "?" + encodedQueryString
+	}
+
+	return s.getPagedResponse(path)
+}
+
+// GetByID returns the task that matches the input ID. If one cannot be found,
+// it returns nil and an error.
+func (s taskService) GetByID(id string) (*Task, error) {
+	path, err := getByIDPath(s, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiGet(s.getClient(), new(Task), path)
+	if err != nil {
+		return nil, createResourceNotFoundError(s.getName(), "ID", id)
+	}
+
+	return resp.(*Task), nil
+}
+
+// Cancel cancels the task that matches the input ID and returns it. A queued
+// task is cancelled before it starts.
+func (s taskService) Cancel(id string) (*Task, error) {
+	path, err := getByIDPath(s, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiPost(s.getClient(), nil, new(Task), path+"/cancel")
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*Task), nil
+}
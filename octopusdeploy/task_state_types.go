@@ -0,0 +1,13 @@
+package octopusdeploy
+
+type TaskState string
+
+const (
+	TaskStateCanceled   = TaskState("Canceled")
+	TaskStateCancelling = TaskState("Cancelling")
+	TaskStateExecuting  = TaskState("Executing")
+	TaskStateFailed     = TaskState("Failed")
+	TaskStateQueued     = TaskState("Queued")
+	TaskStateSuccess    = TaskState("Success")
+	TaskStateTimedOut   = TaskState("TimedOut")
+)
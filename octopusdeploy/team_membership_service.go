@@ -14,3 +14,24 @@ func newTeamMembershipService(sling *sling.Sling, uriTemplate string, previewTea
 		service:         newService(ServiceTeamMembershipService, sling, uriTemplate),
 	}
 }
+
+// PreviewTeam returns the users that would be members of the input team,
+// including the members of its external security groups, without saving
+// the team.
+func (s teamMembershipService) PreviewTeam(team *Team) ([]*User, error) {
+	if team == nil {
+		return nil, createInvalidParameterError(OperationPreviewTeam, ParameterTeam)
+	}
+
+	if err := validateInternalState(s); err != nil {
+		return nil, err
+	}
+
+	if isEmpty(s.previewTeamPath) {
+		return nil, createInvalidPathError(s.getName())
+	}
+
+	items := []*User{}
+	_, err := apiPost(s.getClient(), team, &items, trimTemplate(s.previewTeamPath))
+	return items, err
+}
@@ -0,0 +1,335 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// TeamMembershipMapping describes the desired members of a team: users by
+// email address and external security groups by ID.
+type TeamMembershipMapping struct {
+	ExternalSecurityGroupIDs []string `json:"ExternalSecurityGroups,omitempty"`
+	Team                     string   `json:"Team"`
+	UserEmailAddresses       []string `json:"Users,omitempty"`
+}
+
+// TeamMembershipChangeAction is whether a member is added to or removed from
+// a team.
+type TeamMembershipChangeAction string
+
+// TeamMembershipMemberKind is the kind of team member that is changed.
+type TeamMembershipMemberKind string
+
+const (
+	TeamMembershipChangeActionAdd    TeamMembershipChangeAction = "Add"
+	TeamMembershipChangeActionRemove TeamMembershipChangeAction = "Remove"
+
+	TeamMembershipMemberKindExternalSecurityGroup TeamMembershipMemberKind = "ExternalSecurityGroup"
+	TeamMembershipMemberKindUser                  TeamMembershipMemberKind = "User"
+)
+
+// TeamMembershipChange is a single member added to or removed from a team.
+type TeamMembershipChange struct {
+	Action TeamMembershipChangeAction `json:"Action"`
+	ID     string                     `json:"Id"`
+	Kind   TeamMembershipMemberKind   `json:"Kind"`
+	Name   string                     `json:"Name,omitempty"`
+}
+
+// TeamMembershipDiff lists the changes needed to bring a team to its desired
+// membership.
+type TeamMembershipDiff struct {
+	Changes  []*TeamMembershipChange `json:"Changes"`
+	TeamID   string                  `json:"TeamId"`
+	TeamName string                  `json:"TeamName"`
+
+	team *Team
+}
+
+// TeamMembershipSync is the result of comparing teams against their desired
+// membership and, unless it is a dry run, applying the differences.
+type TeamMembershipSync struct {
+	Applied  bool                  `json:"Applied"`
+	Diffs    []*TeamMembershipDiff `json:"Diffs"`
+	Problems []string              `json:"Problems"`
+}
+
+// ParseTeamMembershipMappings reads team membership mappings from a JSON
+// object keyed by team name, for example:
+//
+//	{"Deployers": {"Users": ["jo@example.com"], "ExternalSecurityGroups": ["S-1-5-21-1"]}}
+//
+// Mappings are returned sorted by team name.
+func ParseTeamMembershipMappings(r io.Reader) ([]*TeamMembershipMapping, error) {
+	document := map[string]*TeamMembershipMapping{}
+	if err := json.NewDecoder(r).Decode(&document); err != nil {
+		return nil, err
+	}
+
+	mappings := []*TeamMembershipMapping{}
+	for team, mapping := range document {
+		if mapping == nil {
+			mapping = &TeamMembershipMapping{}
+		}
+		mapping.Team = team
+		mappings = append(mappings, mapping)
+	}
+
+	sort.Slice(mappings, func(i, j int) bool {
+		return mappings[i].Team < mappings[j].Team
+	})
+
+	return mappings, nil
+}
+
+// DiffTeamMemberships compares the input teams and users against the desired
+// memberships and returns the members to add and remove. Teams and email
+// addresses are matched without regard to case. Teams that are not mapped
+// are left alone; a mapped team is given exactly the mapped users and
+// external security groups. Unknown teams, unknown email addresses and teams
+// whose members cannot be changed are reported as problems and skipped.
+func DiffTeamMemberships(teams []*Team, users []*User, mappings []*TeamMembershipMapping) *TeamMembershipSync {
+	sync := &TeamMembershipSync{
+		Diffs:    []*TeamMembershipDiff{},
+		Problems: []string{},
+	}
+
+	teamsByName := map[string]*Team{}
+	for _, team := range teams {
+		if team != nil {
+			teamsByName[strings.ToLower(team.Name)] = team
+		}
+	}
+
+	usersByEmailAddress := map[string]*User{}
+	usersByID := map[string]*User{}
+	for _, user := range users {
+		if user == nil {
+			continue
+		}
+		usersByID[user.GetID()] = user
+		if !isEmpty(user.EmailAddress) {
+			usersByEmailAddress[strings.ToLower(user.EmailAddress)] = user
+		}
+	}
+
+	for _, mapping := range mappings {
+		if mapping == nil {
+			continue
+		}
+
+		team, ok := teamsByName[strings.ToLower(mapping.Team)]
+		if !ok {
+			sync.Problems = append(sync.Problems, fmt.Sprintf("the team (%s) was not found", mapping.Team))
+			continue
+		}
+
+		desiredUserIDs := []string{}
+		for _, emailAddress := range mapping.UserEmailAddresses {
+			user, ok := usersByEmailAddress[strings.ToLower(strings.TrimSpace(emailAddress))]
+			if !ok {
+				sync.Problems = append(sync.Problems, fmt.Sprintf("no user has the email address (%s) mapped to team %s", emailAddress, team.Name))
+				continue
+			}
+			desiredUserIDs = append(desiredUserIDs, user.GetID())
+		}
+
+		diff := &TeamMembershipDiff{
+			Changes:  []*TeamMembershipChange{},
+			TeamID:   team.GetID(),
+			TeamName: team.Name,
+			team:     team,
+		}
+
+		userName := func(userID string) string {
+			if user, ok := usersByID[userID]; ok {
+				if !isEmpty(user.EmailAddress) {
+					return user.EmailAddress
+				}
+				return user.Username
+			}
+			return empty
+		}
+
+		for _, userID := range getSortedDifference(desiredUserIDs, team.MemberUserIDs) {
+			diff.Changes = append(diff.Changes, &TeamMembershipChange{
+				Action: TeamMembershipChangeActionAdd,
+				ID:     userID,
+				Kind:   TeamMembershipMemberKindUser,
+				Name:   userName(userID),
+			})
+		}
+
+		for _, userID := range getSortedDifference(team.MemberUserIDs, desiredUserIDs) {
+			diff.Changes = append(diff.Changes, &TeamMembershipChange{
+				Action: TeamMembershipChangeActionRemove,
+				ID:     userID,
+				Kind:   TeamMembershipMemberKindUser,
+				Name:   userName(userID),
+			})
+		}
+
+		currentGroupIDs := []string{}
+		groupNames := map[string]string{}
+		for _, group := range team.ExternalSecurityGroups {
+			if group != nil {
+				currentGroupIDs = append(currentGroupIDs, group.ID)
+				groupNames[group.ID] = group.DisplayName
+			}
+		}
+
+		for _, groupID := range getSortedDifference(mapping.ExternalSecurityGroupIDs, currentGroupIDs) {
+			diff.Changes = append(diff.Changes, &TeamMembershipChange{
+				Action: TeamMembershipChangeActionAdd,
+				ID:     groupID,
+				Kind:   TeamMembershipMemberKindExternalSecurityGroup,
+			})
+		}
+
+		for _, groupID := range getSortedDifference(currentGroupIDs, mapping.ExternalSecurityGroupIDs) {
+			diff.Changes = append(diff.Changes, &TeamMembershipChange{
+				Action: TeamMembershipChangeActionRemove,
+				ID:     groupID,
+				Kind:   TeamMembershipMemberKindExternalSecurityGroup,
+				Name:   groupNames[groupID],
+			})
+		}
+
+		if len(diff.Changes) == 0 {
+			continue
+		}
+
+		if !team.CanChangeMembers {
+			sync.Problems = append(sync.Problems, fmt.Sprintf("the members of team %s cannot be changed", team.Name))
+			continue
+		}
+
+		sync.Diffs = append(sync.Diffs, diff)
+	}
+
+	return sync
+}
+
+// getSortedDifference returns the distinct values of a that are not in b,
+// sorted.
+func getSortedDifference(a []string, b []string) []string {
+	difference := []string{}
+	for _, value := range a {
+		if !ValidateStringInSlice(value, b) && !ValidateStringInSlice(value, difference) {
+			difference = append(difference, value)
+		}
+	}
+	sort.Strings(difference)
+	return difference
+}
+
+// apply returns a copy of the team with the changes made to its members.
+func (d *TeamMembershipDiff) apply() *Team {
+	team := *d.team
+	team.MemberUserIDs = append([]string{}, d.team.MemberUserIDs...)
+	team.ExternalSecurityGroups = append([]*NamedReferenceItem{}, d.team.ExternalSecurityGroups...)
+
+	for _, change := range d.Changes {
+		switch {
+		case change.Kind == TeamMembershipMemberKindUser && change.Action == TeamMembershipChangeActionAdd:
+			team.MemberUserIDs = append(team.MemberUserIDs, change.ID)
+		case change.Kind == TeamMembershipMemberKindUser && change.Action == TeamMembershipChangeActionRemove:
+			memberUserIDs := []string{}
+			for _, userID := range team.MemberUserIDs {
+				if userID != change.ID {
+					memberUserIDs = append(memberUserIDs, userID)
+				}
+			}
+			team.MemberUserIDs = memberUserIDs
+		case change.Kind == TeamMembershipMemberKindExternalSecurityGroup && change.Action == TeamMembershipChangeActionAdd:
+			team.ExternalSecurityGroups = append(team.ExternalSecurityGroups, &NamedReferenceItem{
+				DisplayName: change.ID,
+				ID:          change.ID,
+			})
+		case change.Kind == TeamMembershipMemberKindExternalSecurityGroup && change.Action == TeamMembershipChangeActionRemove:
+			groups := []*NamedReferenceItem{}
+			for _, group := range team.ExternalSecurityGroups {
+				if group != nil && group.ID != change.ID {
+					groups = append(groups, group)
+				}
+			}
+			team.ExternalSecurityGroups = groups
+		}
+	}
+
+	return &team
+}
+
+// WriteDiff writes the changes to the input writer as text, one team per
+// block with a line per member prefixed by + or -.
+func (s *TeamMembershipSync) WriteDiff(w io.Writer) error {
+	for _, diff := range s.Diffs {
+		if _, err := fmt.Fprintf(w, "%s (%s)\n", diff.TeamName, diff.TeamID); err != nil {
+			return err
+		}
+
+		for _, change := range diff.Changes {
+			sign := "+"
+			if change.Action == TeamMembershipChangeActionRemove {
+				sign = "-"
+			}
+
+			name := change.ID
+			if !isEmpty(change.Name) && change.Name != change.ID {
+				name = fmt.Sprintf("%s (%s)", change.Name, change.ID)
+			}
+
+			if _, err := fmt.Fprintf(w, "%s %s %s\n", sign, change.Kind, name); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, problem := range s.Problems {
+		if _, err := fmt.Fprintf(w, "! %s\n", problem); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteJSON writes the sync result to the input writer as indented JSON.
+func (s *TeamMembershipSync) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent(empty, tab)
+	return encoder.Encode(s)
+}
+
+// SyncTeamMemberships brings the mapped teams to their desired membership.
+// The differences are computed with DiffTeamMemberships; if dryRun is true,
+// they are returned without changing any team. Problems do not stop the
+// teams that can be synchronized from being updated.
+func (c *Client) SyncTeamMemberships(mappings []*TeamMembershipMapping, dryRun bool) (*TeamMembershipSync, error) {
+	teams, err := c.Teams.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := c.Users.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	sync := DiffTeamMemberships(teams, users, mappings)
+	if dryRun {
+		return sync, nil
+	}
+
+	for _, diff := range sync.Diffs {
+		if _, err := c.Teams.Update(diff.apply()); err != nil {
+			return sync, fmt.Errorf("%s: the team (%s) could not be updated: %v", OperationSyncTeamMemberships, diff.TeamName, err)
+		}
+	}
+	sync.Applied = true
+
+	return sync, nil
+}
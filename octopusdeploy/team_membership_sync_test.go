@@ -0,0 +1,197 @@
+package octopusdeploy
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTeamMembershipMappings(t *testing.T) {
+	input := `{
+		"Operators": {"Users": ["ops@example.com"]},
+		"Deployers": {"Users": ["alice@example.com"], "ExternalSecurityGroups": ["S-1-5-21-1"]},
+		"Auditors": null
+	}`
+
+	mappings, err := ParseTeamMembershipMappings(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Len(t, mappings, 3)
+	require.Equal(t, "Auditors", mappings[0].Team)
+	require.Empty(t, mappings[0].UserEmailAddresses)
+	require.Equal(t, "Deployers", mappings[1].Team)
+	require.Equal(t, []string{"S-1-5-21-1"}, mappings[1].ExternalSecurityGroupIDs)
+
+	_, err = ParseTeamMembershipMappings(strings.NewReader(`[]`))
+	require.Error(t, err)
+}
+
+func createTeamMembershipSyncFixtures() ([]*Team, []*User) {
+	teams := []*Team{
+		{
+			CanChangeMembers:       true,
+			ExternalSecurityGroups: []*NamedReferenceItem{{DisplayName: "Old Group", ID: "S-1-5-21-9"}},
+			MemberUserIDs:          []string{"Users-1", "Users-2"},
+			Name:                   "Deployers",
+			resource:               resource{ID: "Teams-1"},
+		},
+		{
+			MemberUserIDs: []string{"Users-1", "Users-2", "Users-3"},
+			Name:          "Everyone",
+			resource:      resource{ID: "Teams-2"},
+		},
+		{
+			CanChangeMembers: true,
+			MemberUserIDs:    []string{"Users-3"},
+			Name:             "Operators",
+			resource:         resource{ID: "Teams-3"},
+		},
+	}
+
+	users := []*User{
+		{EmailAddress: "alice@example.com", Username: "alice", resource: resource{ID: "Users-1"}},
+		{EmailAddress: "bob@example.com", Username: "bob", resource: resource{ID: "Users-2"}},
+		{EmailAddress: "carol@example.com", Username: "carol", resource: resource{ID: "Users-3"}},
+	}
+
+	return teams, users
+}
+
+func TestDiffTeamMemberships(t *testing.T) {
+	teams, users := createTeamMembershipSyncFixtures()
+	mappings := []*TeamMembershipMapping{
+		{ExternalSecurityGroupIDs: []string{"S-1-5-21-1"}, Team: "deployers", UserEmailAddresses: []string{"Alice@example.com", "carol@example.com", "nobody@example.com"}},
+		{Team: "Everyone", UserEmailAddresses: []string{"alice@example.com"}},
+		{Team: "Operators", UserEmailAddresses: []string{"carol@example.com"}},
+		{Team: "Missing"},
+	}
+
+	sync := DiffTeamMemberships(teams, users, mappings)
+	require.False(t, sync.Applied)
+	require.Len(t, sync.Diffs, 1)
+	require.Equal(t, "Teams-1", sync.Diffs[0].TeamID)
+	require.Equal(t, []*TeamMembershipChange{
+		{Action: TeamMembershipChangeActionAdd, ID: "Users-3", Kind: TeamMembershipMemberKindUser, Name: "carol@example.com"},
+		{Action: TeamMembershipChangeActionRemove, ID: "Users-2", Kind: TeamMembershipMemberKindUser, Name: "bob@example.com"},
+		{Action: TeamMembershipChangeActionAdd, ID: "S-1-5-21-1", Kind: TeamMembershipMemberKindExternalSecurityGroup},
+		{Action: TeamMembershipChangeActionRemove, ID: "S-1-5-21-9", Kind: TeamMembershipMemberKindExternalSecurityGroup, Name: "Old Group"},
+	}, sync.Diffs[0].Changes)
+	require.Equal(t, []string{
+		"no user has the email address (nobody@example.com) mapped to team Deployers",
+		"the members of team Everyone cannot be changed",
+		"the team (Missing) was not found",
+	}, sync.Problems)
+
+	team := sync.Diffs[0].apply()
+	require.Equal(t, []string{"Users-1", "Users-3"}, team.MemberUserIDs)
+	require.Equal(t, []*NamedReferenceItem{{DisplayName: "S-1-5-21-1", ID: "S-1-5-21-1"}}, team.ExternalSecurityGroups)
+	require.Equal(t, []string{"Users-1", "Users-2"}, teams[0].MemberUserIDs)
+
+	var buffer bytes.Buffer
+	require.NoError(t, sync.WriteDiff(&buffer))
+	require.Equal(t, `Deployers (Teams-1)
++ User carol@example.com (Users-3)
+- User bob@example.com (Users-2)
++ ExternalSecurityGroup S-1-5-21-1
+- ExternalSecurityGroup Old Group (S-1-5-21-9)
+! no user has the email address (nobody@example.com) mapped to team Deployers
+! the members of team Everyone cannot be changed
+! the team (Missing) was not found
+`, buffer.String())
+}
+
+func TestSyncTeamMemberships(t *testing.T) {
+	directory := newFakeUserDirectory(t)
+	directory.teams, directory.users = createTeamMembershipSyncFixtures()
+	client := directory.client()
+
+	mappings := []*TeamMembershipMapping{
+		{Team: "Deployers", UserEmailAddresses: []string{"alice@example.com"}},
+		{Team: "Operators", UserEmailAddresses: []string{"carol@example.com"}},
+	}
+
+	sync, err := client.SyncTeamMemberships(mappings, true)
+	require.NoError(t, err)
+	require.False(t, sync.Applied)
+	require.Len(t, sync.Diffs, 1)
+	require.Empty(t, directory.updatedTeams)
+
+	sync, err = client.SyncTeamMemberships(mappings, false)
+	require.NoError(t, err)
+	require.True(t, sync.Applied)
+	require.Equal(t, []string{"Teams-1"}, directory.updatedTeams)
+	require.Equal(t, []string{"Users-1"}, directory.teams[0].MemberUserIDs)
+	require.Empty(t, directory.teams[0].ExternalSecurityGroups)
+
+	// a second run finds nothing to change
+	sync, err = client.SyncTeamMemberships(mappings, false)
+	require.NoError(t, err)
+	require.Empty(t, sync.Diffs)
+	require.Equal(t, []string{"Teams-1"}, directory.updatedTeams)
+}
+
+func TestTeamMembershipServicePreviewTeam(t *testing.T) {
+	service := newTeamMembershipService(nil, TestURITeamMembership, TestURITeamMembershipPreviewTeam)
+	testNewService(t, service, TestURITeamMembership, ServiceTeamMembershipService)
+
+	_, err := service.PreviewTeam(nil)
+	require.Equal(t, createInvalidParameterError(OperationPreviewTeam, ParameterTeam), err)
+
+	client := createFakeSling(func(r *http.Request) (int, string) {
+		if r.Method == http.MethodPost && r.URL.Path == "/api/teammembership/previewteam" {
+			return http.StatusOK, `[{"Id":"Users-1","Username":"alice"},{"Id":"Users-2","Username":"bob"}]`
+		}
+		return http.StatusNotFound, `{}`
+	})
+
+	service = newTeamMembershipService(client, TestURITeamMembership, TestURITeamMembershipPreviewTeam)
+	users, err := service.PreviewTeam(NewTeam("Deployers"))
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	require.Equal(t, "bob", users[1].Username)
+
+	service = newTeamMembershipService(client, TestURITeamMembership, emptyString)
+	_, err = service.PreviewTeam(NewTeam("Deployers"))
+	require.Equal(t, createInvalidPathError(ServiceTeamMembershipService), err)
+}
+
+func TestExternalSearch(t *testing.T) {
+	client := createFakeSling(func(r *http.Request) (int, string) {
+		switch r.URL.Path {
+		case "/api/users/external-search":
+			return http.StatusOK, `{"Results":[{"IdentityProviderName":"Azure AD","IsSuccessful":true,"Identities":[{"IdentityProviderName":"Azure AD","Claims":{"email":{"IsIdentifyingClaim":true,"Value":"` + r.URL.Query().Get("partialName") + `@example.com"}}}]}]}`
+		case "/api/externalsecuritygroupproviders":
+			return http.StatusOK, `[{"Name":"Active Directory","SupportsGroupLookup":true,"Links":{"Groups":"/api/externalgroups/directoryServices{?partialName}"}}]`
+		case "/api/externalgroups/directoryServices":
+			return http.StatusOK, `[{"Id":"S-1-5-21-1","DisplayName":"` + r.URL.Query().Get("partialName") + ` Deployers"}]`
+		}
+		return http.StatusNotFound, `{}`
+	})
+
+	userService := newUserService(client, TestURIUsers, emptyString, emptyString, emptyString, TestURIExternalUserSearch, emptyString, emptyString, emptyString, emptyString, emptyString)
+	_, err := userService.SearchExternalUsers(emptyString)
+	require.Equal(t, createInvalidParameterError(OperationSearchExternalUsers, ParameterPartialName), err)
+
+	results, err := userService.SearchExternalUsers("alice")
+	require.NoError(t, err)
+	require.Len(t, results.Results, 1)
+	require.True(t, results.Results[0].IsSuccessful)
+	require.Equal(t, "alice@example.com", results.Results[0].Identities[0].Claims["email"].Value)
+
+	providerService := newExternalSecurityGroupProviderService(client, TestURIExternalSecurityGroupProviders)
+	providers, err := providerService.GetAll()
+	require.NoError(t, err)
+	require.Len(t, providers, 1)
+
+	_, err = providerService.SearchGroups(nil, "Prod")
+	require.Equal(t, createInvalidParameterError(OperationSearchGroups, ParameterSecurityGroupProvider), err)
+
+	groups, err := providerService.SearchGroups(providers[0], "Prod")
+	require.NoError(t, err)
+	require.Equal(t, []*NamedReferenceItem{{DisplayName: "Prod Deployers", ID: "S-1-5-21-1"}}, groups)
+
+	_, err = providerService.SearchGroups(&ExternalSecurityGroupProvider{Name: "Okta"}, "Prod")
+	require.Equal(t, createInvalidPathError("Okta"), err)
+}
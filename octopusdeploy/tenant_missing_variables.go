@@ -0,0 +1,29 @@
+package octopusdeploy
+
+// TenantMissingVariables represents the variable templates for which a tenant
+// has not supplied a value.
+type TenantMissingVariables struct {
+	MissingVariables []*MissingVariable `json:"MissingVariables"`
+	TenantID         string             `json:"TenantId,omitempty"`
+
+	resource
+}
+
+// MissingVariable identifies a variable template for which a tenant has not
+// supplied a value. Project templates are identified by a project and an
+// environment, and library variable set templates by a library variable set.
+type MissingVariable struct {
+	EnvironmentID        string `json:"EnvironmentId,omitempty"`
+	LibraryVariableSetID string `json:"LibraryVariableSetId,omitempty"`
+	ProjectID            string `json:"ProjectId,omitempty"`
+	VariableTemplateID   string `json:"VariableTemplateId,omitempty"`
+	VariableTemplateName string `json:"VariableTemplateName,omitempty"`
+
+	resource
+}
+
+// MultiTenancyStatus indicates whether multi-tenancy is enabled on the
+// Octopus server.
+type MultiTenancyStatus struct {
+	Enabled bool `json:"Enabled"`
+}
@@ -2,12 +2,14 @@ package octopusdeploy
 
 import (
 	"github.com/dghubble/sling"
+	"github.com/google/go-querystring/query"
 )
 
 type tenantService struct {
-	missingVariablesPath string
-	statusPath           string
-	tagTestPath          string
+	missingVariablesPath             string
+	missingVariablesUnsupportedError error
+	statusPath                       string
+	tagTestPath                      string
 
 	canDeleteService
 }
@@ -72,6 +74,30 @@ func (s tenantService) Add(resource *Tenant) (*Tenant, error) {
 	return resp.(*Tenant), nil
 }
 
+// Get returns a collection of tenants based on the criteria defined by its
+// input query parameter. If an error occurs, an empty collection is returned
+// along with the associated error.
+func (s tenantService) Get(tenantsQuery TenantsQuery) (*Tenants, error) {
+	err := validateInternalState(s)
+	if err != nil {
+		return &Tenants{}, err
+	}
+
+	v, _ := query.Values(tenantsQuery)
+	path := s.BasePath
+	encodedQueryString := v.Encode()
+	if len(encodedQueryString) > 0 {
+		path += "?" + encodedQueryString
+	}
+
+	resp, err := apiGet(s.getClient(), new(Tenants), path)
+	if err != nil {
+		return &Tenants{}, err
+	}
+
+	return resp.(*Tenants), nil
+}
+
 // GetAll returns all tenants. If none can be found or an error occurs, it
 // returns an empty collection.
 func (s tenantService) GetAll() ([]*Tenant, error) {
@@ -151,3 +177,38 @@ func (s tenantService) Update(resource *Tenant) (*Tenant, error) {
 
 	return resp.(*Tenant), nil
 }
+
+// GetMissingVariables returns the variable templates for which tenants have
+// not supplied a value, based on the criteria defined by its input query
+// parameter. If an error occurs, an empty collection is returned along with
+// the associated error.
+func (s tenantService) GetMissingVariables(tenantsMissingVariablesQuery TenantsMissingVariablesQuery) ([]*TenantMissingVariables, error) {
+	items := []*TenantMissingVariables{}
+	if isEmpty(s.missingVariablesPath) {
+		return items, s.getPathError(s.missingVariablesUnsupportedError)
+	}
+
+	v, _ := query.Values(tenantsMissingVariablesQuery)
+	path := trimTemplate(s.missingVariablesPath)
+	encodedQueryString := v.Encode()
+	if len(encodedQueryString) > 0 {
+		path += "?" + encodedQueryString
+	}
+
+	_, err := apiGet(s.getClient(), &items, path)
+	return items, err
+}
+
+// GetStatus returns whether multi-tenancy is enabled on the Octopus server.
+func (s tenantService) GetStatus() (*MultiTenancyStatus, error) {
+	if isEmpty(s.statusPath) {
+		return nil, createInvalidPathError(s.getName())
+	}
+
+	resp, err := apiGet(s.getClient(), new(MultiTenancyStatus), s.statusPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*MultiTenancyStatus), nil
+}
@@ -0,0 +1,300 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+)
+
+// TenantVariableReport lists the variable templates for which tenants have
+// not supplied a value and which have no default value to fall back on.
+type TenantVariableReport struct {
+	Items []*TenantVariableReportItem `json:"Items"`
+}
+
+// TenantVariableReportItem identifies a single missing tenant variable value.
+// Project templates are identified by a project and an environment, and
+// library variable set templates by a library variable set.
+type TenantVariableReportItem struct {
+	DetectedLocally        bool   `json:"DetectedLocally"`
+	EnvironmentID          string `json:"EnvironmentId,omitempty"`
+	LibraryVariableSetID   string `json:"LibraryVariableSetId,omitempty"`
+	LibraryVariableSetName string `json:"LibraryVariableSetName,omitempty"`
+	ProjectID              string `json:"ProjectId,omitempty"`
+	ProjectName            string `json:"ProjectName,omitempty"`
+	ReportedByServer       bool   `json:"ReportedByServer"`
+	TemplateID             string `json:"TemplateId,omitempty"`
+	TemplateName           string `json:"TemplateName,omitempty"`
+	TenantID               string `json:"TenantId"`
+	TenantName             string `json:"TenantName,omitempty"`
+}
+
+func (i *TenantVariableReportItem) key() string {
+	return fmt.Sprintf("%s|%s|%s|%s|%s", i.TenantID, i.ProjectID, i.EnvironmentID, i.LibraryVariableSetID, i.TemplateID)
+}
+
+// hasPropertyValue returns true if the property value is set; sensitive
+// values are set when the server reports that they have a value.
+func hasPropertyValue(value *PropertyValueResource) bool {
+	if value == nil {
+		return false
+	}
+
+	if value.SensitiveValue != nil {
+		return value.SensitiveValue.HasValue
+	}
+
+	return value.PropertyValue != nil && len(*value.PropertyValue) > 0
+}
+
+// isTemplateValueMissing returns true if neither the tenant nor the template
+// default supplies a value.
+func isTemplateValueMissing(template *ActionTemplateParameter, value PropertyValueResource, ok bool) bool {
+	if ok && hasPropertyValue(&value) {
+		return false
+	}
+
+	return !hasPropertyValue(template.DefaultValue)
+}
+
+// BuildTenantVariableReport combines the missing variables reported by the
+// Octopus server with a local check of the supplied tenant variables. The
+// local check treats a template as missing when the tenant supplies no value
+// for it and the project or library variable set template has no default.
+// Tenants are optional; when present they provide tenant names and the
+// environments each project is connected to.
+func BuildTenantVariableReport(tenants []*Tenant, tenantVariables []*TenantVariables, missingVariables []*TenantMissingVariables) *TenantVariableReport {
+	tenantsByID := map[string]*Tenant{}
+	for _, tenant := range tenants {
+		tenantsByID[tenant.GetID()] = tenant
+	}
+
+	items := map[string]*TenantVariableReportItem{}
+	add := func(item *TenantVariableReportItem) *TenantVariableReportItem {
+		if existing, ok := items[item.key()]; ok {
+			return existing
+		}
+
+		if tenant, ok := tenantsByID[item.TenantID]; ok {
+			item.TenantName = tenant.Name
+		}
+
+		items[item.key()] = item
+		return item
+	}
+
+	for _, variables := range tenantVariables {
+		for projectID, projectVariable := range variables.ProjectVariables {
+			environmentIDs := []string{}
+			if tenant, ok := tenantsByID[variables.TenantID]; ok {
+				environmentIDs = tenant.ProjectEnvironments[projectID]
+			} else {
+				for environmentID := range projectVariable.Variables {
+					environmentIDs = append(environmentIDs, environmentID)
+				}
+			}
+
+			for _, environmentID := range environmentIDs {
+				for _, template := range projectVariable.Templates {
+					value, ok := projectVariable.Variables[environmentID][template.GetID()]
+					if !isTemplateValueMissing(template, value, ok) {
+						continue
+					}
+
+					item := add(&TenantVariableReportItem{
+						EnvironmentID: environmentID,
+						ProjectID:     projectID,
+						ProjectName:   projectVariable.ProjectName,
+						TemplateID:    template.GetID(),
+						TemplateName:  template.Name,
+						TenantID:      variables.TenantID,
+						TenantName:    variables.TenantName,
+					})
+					item.DetectedLocally = true
+				}
+			}
+		}
+
+		for libraryVariableSetID, libraryVariable := range variables.LibraryVariables {
+			for _, template := range libraryVariable.Templates {
+				value, ok := libraryVariable.Variables[template.GetID()]
+				if !isTemplateValueMissing(template, value, ok) {
+					continue
+				}
+
+				item := add(&TenantVariableReportItem{
+					LibraryVariableSetID:   libraryVariableSetID,
+					LibraryVariableSetName: libraryVariable.LibraryVariableSetName,
+					TemplateID:             template.GetID(),
+					TemplateName:           template.Name,
+					TenantID:               variables.TenantID,
+					TenantName:             variables.TenantName,
+				})
+				item.DetectedLocally = true
+			}
+		}
+	}
+
+	for _, tenantMissingVariables := range missingVariables {
+		for _, missingVariable := range tenantMissingVariables.MissingVariables {
+			item := add(&TenantVariableReportItem{
+				EnvironmentID:        missingVariable.EnvironmentID,
+				LibraryVariableSetID: missingVariable.LibraryVariableSetID,
+				ProjectID:            missingVariable.ProjectID,
+				TemplateID:           missingVariable.VariableTemplateID,
+				TemplateName:         missingVariable.VariableTemplateName,
+				TenantID:             tenantMissingVariables.TenantID,
+			})
+			item.ReportedByServer = true
+		}
+	}
+
+	report := &TenantVariableReport{Items: []*TenantVariableReportItem{}}
+	for _, item := range items {
+		report.Items = append(report.Items, item)
+	}
+
+	sort.Slice(report.Items, func(i, j int) bool {
+		a, b := report.Items[i], report.Items[j]
+		if a.TenantID != b.TenantID {
+			return a.TenantID < b.TenantID
+		}
+
+		if a.ProjectID != b.ProjectID {
+			return a.ProjectID < b.ProjectID
+		}
+
+		if a.EnvironmentID != b.EnvironmentID {
+			return a.EnvironmentID < b.EnvironmentID
+		}
+
+		if a.LibraryVariableSetID != b.LibraryVariableSetID {
+			return a.LibraryVariableSetID < b.LibraryVariableSetID
+		}
+
+		return a.TemplateName < b.TemplateName
+	})
+
+	return report
+}
+
+// GetByTenantID returns the missing values of the tenant that matches the
+// input ID.
+func (r *TenantVariableReport) GetByTenantID(tenantID string) []*TenantVariableReportItem {
+	items := []*TenantVariableReportItem{}
+	for _, item := range r.Items {
+		if item.TenantID == tenantID {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// IsComplete returns true if the tenant that matches the input ID has a value
+// for every variable template.
+func (r *TenantVariableReport) IsComplete(tenantID string) bool {
+	return len(r.GetByTenantID(tenantID)) == 0
+}
+
+// WriteJSON writes the report to the input writer as indented JSON.
+func (r *TenantVariableReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent(empty, tab)
+	return encoder.Encode(r)
+}
+
+// WriteTable writes the report to the input writer as a table with one row
+// per missing value.
+func (r *TenantVariableReport) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "TENANT\tPROJECT\tENVIRONMENT\tLIBRARY VARIABLE SET\tTEMPLATE\tSOURCE")
+
+	for _, item := range r.Items {
+		tenant := item.TenantName
+		if isEmpty(tenant) {
+			tenant = item.TenantID
+		}
+
+		project := item.ProjectName
+		if isEmpty(project) {
+			project = item.ProjectID
+		}
+
+		libraryVariableSet := item.LibraryVariableSetName
+		if isEmpty(libraryVariableSet) {
+			libraryVariableSet = item.LibraryVariableSetID
+		}
+
+		source := "server"
+		if item.DetectedLocally && item.ReportedByServer {
+			source = "server, local"
+		} else if item.DetectedLocally {
+			source = "local"
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", tenant, project, item.EnvironmentID, libraryVariableSet, item.TemplateName, source)
+	}
+
+	return tw.Flush()
+}
+
+// GetTenantVariableReport builds a report of the variable values tenants are
+// missing, based on the criteria defined by its input query parameter. It
+// combines the missing variables reported by the Octopus server with a local
+// check against the project and library variable set template defaults.
+func (c *Client) GetTenantVariableReport(tenantsMissingVariablesQuery TenantsMissingVariablesQuery) (*TenantVariableReport, error) {
+	tenantsMissingVariablesQuery.IncludeDetails = true
+	missingVariables, err := c.Tenants.GetMissingVariables(tenantsMissingVariablesQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	tenants := []*Tenant{}
+	tenantVariables := []*TenantVariables{}
+
+	if !isEmpty(tenantsMissingVariablesQuery.TenantID) {
+		tenant, err := c.Tenants.GetByID(tenantsMissingVariablesQuery.TenantID)
+		if err != nil {
+			return nil, err
+		}
+
+		variables, err := c.TenantVariables.GetByTenantID(tenant.GetID())
+		if err != nil {
+			return nil, err
+		}
+
+		tenants = append(tenants, tenant)
+		tenantVariables = append(tenantVariables, variables)
+	} else {
+		tenants, err = c.Tenants.GetAll()
+		if err != nil {
+			return nil, err
+		}
+
+		tenantVariables, err = c.TenantVariables.GetAll(TenantVariablesQuery{ProjectID: tenantsMissingVariablesQuery.ProjectID})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	report := BuildTenantVariableReport(tenants, tenantVariables, missingVariables)
+
+	items := []*TenantVariableReportItem{}
+	for _, item := range report.Items {
+		if !isEmpty(tenantsMissingVariablesQuery.ProjectID) && !isEmpty(item.ProjectID) && item.ProjectID != tenantsMissingVariablesQuery.ProjectID {
+			continue
+		}
+
+		if len(tenantsMissingVariablesQuery.EnvironmentID) > 0 && !isEmpty(item.EnvironmentID) && !ValidateStringInSlice(item.EnvironmentID, tenantsMissingVariablesQuery.EnvironmentID) {
+			continue
+		}
+
+		items = append(items, item)
+	}
+	report.Items = items
+
+	return report, nil
+}
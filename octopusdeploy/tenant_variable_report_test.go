@@ -0,0 +1,102 @@
+package octopusdeploy
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildTenantVariableReport(t *testing.T) {
+	var tenantVariables TenantVariables
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"TenantId": "Tenants-1",
+		"ProjectVariables": {
+			"Projects-1": {
+				"ProjectName": "Web",
+				"Templates": [
+					{"Id": "template-url", "Name": "Url"},
+					{"Id": "template-port", "Name": "Port", "DefaultValue": "443"},
+					{"Id": "template-password", "Name": "Password", "DisplaySettings": {"Octopus.ControlType": "Sensitive"}}
+				],
+				"Variables": {
+					"Environments-1": {"template-url": "https://acme.example.com", "template-password": {"HasValue": true}},
+					"Environments-2": {"template-url": ""}
+				}
+			}
+		},
+		"LibraryVariables": {
+			"LibraryVariableSets-1": {
+				"LibraryVariableSetName": "Common",
+				"Templates": [{"Id": "template-region", "Name": "Region"}],
+				"Variables": {}
+			}
+		}
+	}`), &tenantVariables))
+
+	tenant := NewTenant("Acme", emptyString)
+	tenant.ID = "Tenants-1"
+	tenant.ProjectEnvironments = map[string][]string{"Projects-1": {"Environments-1", "Environments-2"}}
+
+	missingVariables := []*TenantMissingVariables{
+		{
+			TenantID: "Tenants-1",
+			MissingVariables: []*MissingVariable{
+				{EnvironmentID: "Environments-2", ProjectID: "Projects-1", VariableTemplateID: "template-url", VariableTemplateName: "Url"},
+			},
+		},
+		{
+			TenantID: "Tenants-2",
+			MissingVariables: []*MissingVariable{
+				{LibraryVariableSetID: "LibraryVariableSets-1", VariableTemplateID: "template-region", VariableTemplateName: "Region"},
+			},
+		},
+	}
+
+	report := BuildTenantVariableReport([]*Tenant{tenant}, []*TenantVariables{&tenantVariables}, missingVariables)
+	require.Len(t, report.Items, 4)
+
+	require.Equal(t, &TenantVariableReportItem{
+		DetectedLocally:        true,
+		LibraryVariableSetID:   "LibraryVariableSets-1",
+		LibraryVariableSetName: "Common",
+		TemplateID:             "template-region",
+		TemplateName:           "Region",
+		TenantID:               "Tenants-1",
+		TenantName:             "Acme",
+	}, report.Items[0])
+
+	require.Equal(t, "Environments-2", report.Items[1].EnvironmentID)
+	require.Equal(t, "Password", report.Items[1].TemplateName)
+	require.True(t, report.Items[1].DetectedLocally)
+	require.False(t, report.Items[1].ReportedByServer)
+
+	require.Equal(t, "Environments-2", report.Items[2].EnvironmentID)
+	require.Equal(t, "Url", report.Items[2].TemplateName)
+	require.True(t, report.Items[2].DetectedLocally)
+	require.True(t, report.Items[2].ReportedByServer)
+
+	require.Equal(t, "Tenants-2", report.Items[3].TenantID)
+	require.False(t, report.Items[3].DetectedLocally)
+	require.True(t, report.Items[3].ReportedByServer)
+
+	require.False(t, report.IsComplete("Tenants-1"))
+	require.Len(t, report.GetByTenantID("Tenants-1"), 3)
+	require.True(t, report.IsComplete("Tenants-3"))
+
+	var table bytes.Buffer
+	require.NoError(t, report.WriteTable(&table))
+	lines := strings.Split(strings.TrimSpace(table.String()), "\n")
+	require.Len(t, lines, 5)
+	require.True(t, strings.HasPrefix(lines[0], "TENANT"))
+	require.Contains(t, lines[3], "server, local")
+
+	var buffer bytes.Buffer
+	require.NoError(t, report.WriteJSON(&buffer))
+
+	var decoded TenantVariableReport
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &decoded))
+	require.Equal(t, report, &decoded)
+}
@@ -1,13 +1,190 @@
 package octopusdeploy
 
-import "github.com/dghubble/sling"
+import (
+	"github.com/fqjony/go-octopusdeploy/uritemplates"
+	"github.com/dghubble/sling"
+)
 
 type tenantVariableService struct {
+	tenantsPath string
+
 	service
 }
 
-func newTenantVariableService(sling *sling.Sling, uriTemplate string) *tenantVariableService {
+func newTenantVariableService(sling *sling.Sling, uriTemplate string, tenantsPath string) *tenantVariableService {
 	return &tenantVariableService{
-		service: newService(ServiceTenantVariableService, sling, uriTemplate),
+		tenantsPath: tenantsPath,
+		service:     newService(ServiceTenantVariableService, sling, uriTemplate),
+	}
+}
+
+// getByTenantIDPath returns the path of the variables of the tenant that
+// matches the input ID.
+func (s tenantVariableService) getByTenantIDPath(tenantID string) (string, error) {
+	if isEmpty(tenantID) {
+		return emptyString, createInvalidParameterError(OperationGetByTenantID, ParameterTenantID)
+	}
+
+	err := validateInternalState(s)
+	if err != nil {
+		return emptyString, err
+	}
+
+	template, err := uritemplates.Parse(s.tenantsPath)
+	if err != nil {
+		return emptyString, err
+	}
+
+	values := make(map[string]interface{})
+	values[ParameterID] = tenantID
+
+	path, err := template.Expand(values)
+	if err != nil {
+		return emptyString, err
+	}
+
+	if isEmpty(path) {
+		return emptyString, createInvalidPathError(s.getName())
+	}
+
+	return path + "/variables", nil
+}
+
+// GetAll returns the variables of every tenant, optionally limited to those
+// connected to a project through the input query parameter.
+func (s tenantVariableService) GetAll(tenantVariablesQuery ...TenantVariablesQuery) ([]*TenantVariables, error) {
+	items := []*TenantVariables{}
+
+	err := validateInternalState(s)
+	if err != nil {
+		return items, err
+	}
+
+	var values interface{} = make(map[string]interface{})
+	if len(tenantVariablesQuery) > 0 {
+		values = tenantVariablesQuery[0]
+	}
+
+	path, err := s.getURITemplate().Expand(values)
+	if err != nil {
+		return items, err
+	}
+
+	_, err = apiGet(s.getClient(), &items, path)
+	return items, err
+}
+
+// GetByTenantID returns the variables of the tenant that matches the input ID.
+func (s tenantVariableService) GetByTenantID(tenantID string) (*TenantVariables, error) {
+	path, err := s.getByTenantIDPath(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiGet(s.getClient(), new(TenantVariables), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*TenantVariables), nil
+}
+
+// Update modifies the variables of a tenant based on the ones provided as
+// input.
+func (s tenantVariableService) Update(tenantVariables *TenantVariables) (*TenantVariables, error) {
+	if tenantVariables == nil {
+		return nil, createInvalidParameterError(OperationUpdate, ParameterTenantVariables)
+	}
+
+	path, err := s.getByTenantIDPath(tenantVariables.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiUpdate(s.getClient(), tenantVariables, new(TenantVariables), path)
+	if err != nil {
+		return nil, err
 	}
+
+	return resp.(*TenantVariables), nil
+}
+
+// SetValue sets the value a tenant supplies for a project template in an
+// environment and saves the variables of the tenant. The value is sent as a
+// sensitive value when the template is sensitive.
+func (s tenantVariableService) SetValue(tenantID string, projectID string, environmentID string, templateName string, value string) (*TenantVariables, error) {
+	if isEmpty(projectID) {
+		return nil, createInvalidParameterError(OperationSetValue, ParameterProjectID)
+	}
+
+	if isEmpty(environmentID) {
+		return nil, createInvalidParameterError(OperationSetValue, ParameterEnvironmentID)
+	}
+
+	if isEmpty(templateName) {
+		return nil, createInvalidParameterError(OperationSetValue, ParameterTemplateName)
+	}
+
+	tenantVariables, err := s.GetByTenantID(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	projectVariable, ok := tenantVariables.ProjectVariables[projectID]
+	if !ok || projectVariable == nil {
+		return nil, createItemNotFoundError(s.getName(), OperationSetValue, projectID)
+	}
+
+	template := getTemplateByName(projectVariable.Templates, templateName)
+	if template == nil {
+		return nil, createItemNotFoundError(s.getName(), OperationSetValue, templateName)
+	}
+
+	if projectVariable.Variables == nil {
+		projectVariable.Variables = map[string]map[string]PropertyValueResource{}
+	}
+
+	if projectVariable.Variables[environmentID] == nil {
+		projectVariable.Variables[environmentID] = map[string]PropertyValueResource{}
+	}
+
+	projectVariable.Variables[environmentID][template.GetID()] = newTemplateValue(template, value)
+
+	return s.Update(tenantVariables)
+}
+
+// SetLibraryVariableValue sets the value a tenant supplies for a library
+// variable set template and saves the variables of the tenant. The value is
+// sent as a sensitive value when the template is sensitive.
+func (s tenantVariableService) SetLibraryVariableValue(tenantID string, libraryVariableSetID string, templateName string, value string) (*TenantVariables, error) {
+	if isEmpty(libraryVariableSetID) {
+		return nil, createInvalidParameterError(OperationSetValue, ParameterLibraryVariableSetID)
+	}
+
+	if isEmpty(templateName) {
+		return nil, createInvalidParameterError(OperationSetValue, ParameterTemplateName)
+	}
+
+	tenantVariables, err := s.GetByTenantID(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	libraryVariable, ok := tenantVariables.LibraryVariables[libraryVariableSetID]
+	if !ok || libraryVariable == nil {
+		return nil, createItemNotFoundError(s.getName(), OperationSetValue, libraryVariableSetID)
+	}
+
+	template := getTemplateByName(libraryVariable.Templates, templateName)
+	if template == nil {
+		return nil, createItemNotFoundError(s.getName(), OperationSetValue, templateName)
+	}
+
+	if libraryVariable.Variables == nil {
+		libraryVariable.Variables = map[string]PropertyValueResource{}
+	}
+
+	libraryVariable.Variables[template.GetID()] = newTemplateValue(template, value)
+
+	return s.Update(tenantVariables)
 }
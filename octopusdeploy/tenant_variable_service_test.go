@@ -0,0 +1,126 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/dghubble/sling"
+	"github.com/stretchr/testify/require"
+)
+
+const testTenantVariables = `{
+	"TenantId": "Tenants-1",
+	"TenantName": "Acme",
+	"ProjectVariables": {
+		"Projects-1": {
+			"ProjectId": "Projects-1",
+			"Templates": [
+				{"Id": "template-url", "Name": "Url"},
+				{"Id": "template-password", "Name": "Password", "DisplaySettings": {"Octopus.ControlType": "Sensitive"}}
+			],
+			"Variables": {"Environments-1": {"template-url": "https://acme.example.com"}}
+		}
+	},
+	"LibraryVariables": {
+		"LibraryVariableSets-1": {
+			"LibraryVariableSetId": "LibraryVariableSets-1",
+			"Templates": [{"Id": "template-region", "Name": "Region"}],
+			"Variables": {}
+		}
+	}
+}`
+
+func TestTenantVariableServiceNew(t *testing.T) {
+	ServiceFunction := newTenantVariableService
+	client := &sling.Sling{}
+	uriTemplate := emptyString
+	tenantsPath := emptyString
+	ServiceName := ServiceTenantVariableService
+
+	testCases := []struct {
+		name        string
+		f           func(*sling.Sling, string, string) *tenantVariableService
+		client      *sling.Sling
+		uriTemplate string
+		tenantsPath string
+	}{
+		{"NilClient", ServiceFunction, nil, uriTemplate, tenantsPath},
+		{"EmptyURITemplate", ServiceFunction, client, emptyString, tenantsPath},
+		{"URITemplateWithWhitespace", ServiceFunction, client, whitespaceString, tenantsPath},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := tc.f(tc.client, tc.uriTemplate, tc.tenantsPath)
+			testNewService(t, service, uriTemplate, ServiceName)
+		})
+	}
+}
+
+func TestTenantVariableServiceParameters(t *testing.T) {
+	service := newTenantVariableService(nil, TestURITenantVariables, TestURITenants)
+
+	resource, err := service.GetByTenantID(emptyString)
+	require.Equal(t, createInvalidParameterError(OperationGetByTenantID, ParameterTenantID), err)
+	require.Nil(t, resource)
+
+	resource, err = service.Update(nil)
+	require.Equal(t, createInvalidParameterError(OperationUpdate, ParameterTenantVariables), err)
+	require.Nil(t, resource)
+
+	resource, err = service.SetValue("Tenants-1", "Projects-1", whitespaceString, "Url", "value")
+	require.Equal(t, createInvalidParameterError(OperationSetValue, ParameterEnvironmentID), err)
+	require.Nil(t, resource)
+}
+
+func TestTenantVariableServiceGetAll(t *testing.T) {
+	client := createFakeSling(func(r *http.Request) (int, string) {
+		require.Equal(t, "/api/Spaces-1/tenantvariables/all", r.URL.Path)
+		require.Equal(t, "Projects-1", r.URL.Query().Get("projectId"))
+		return http.StatusOK, "[" + testTenantVariables + "]"
+	})
+	service := newTenantVariableService(client, TestURITenantVariables, TestURITenants)
+
+	tenantVariables, err := service.GetAll(TenantVariablesQuery{ProjectID: "Projects-1"})
+	require.NoError(t, err)
+	require.Len(t, tenantVariables, 1)
+	require.Equal(t, "Acme", tenantVariables[0].TenantName)
+
+	value := tenantVariables[0].ProjectVariables["Projects-1"].Variables["Environments-1"]["template-url"]
+	require.Equal(t, PropertyValue("https://acme.example.com"), *value.PropertyValue)
+}
+
+func TestTenantVariableServiceSetValue(t *testing.T) {
+	var updated map[string]interface{}
+	client := createFakeSling(func(r *http.Request) (int, string) {
+		require.Equal(t, "/api/Spaces-1/tenants/Tenants-1/variables", r.URL.Path)
+		if r.Method == http.MethodPut {
+			body, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(body, &updated))
+			return http.StatusOK, string(body)
+		}
+		return http.StatusOK, testTenantVariables
+	})
+	service := newTenantVariableService(client, TestURITenantVariables, TestURITenants)
+
+	_, err := service.SetValue("Tenants-1", "Projects-1", "Environments-2", "Password", "secret")
+	require.NoError(t, err)
+
+	variables := updated["ProjectVariables"].(map[string]interface{})["Projects-1"].(map[string]interface{})["Variables"].(map[string]interface{})
+	require.Equal(t, map[string]interface{}{"HasValue": true, "NewValue": "secret"}, variables["Environments-2"].(map[string]interface{})["template-password"])
+	require.Equal(t, "https://acme.example.com", variables["Environments-1"].(map[string]interface{})["template-url"])
+
+	_, err = service.SetLibraryVariableValue("Tenants-1", "LibraryVariableSets-1", "Region", "eu-west-1")
+	require.NoError(t, err)
+
+	libraryVariables := updated["LibraryVariables"].(map[string]interface{})["LibraryVariableSets-1"].(map[string]interface{})["Variables"].(map[string]interface{})
+	require.Equal(t, "eu-west-1", libraryVariables["template-region"])
+
+	_, err = service.SetValue("Tenants-1", "Projects-1", "Environments-1", "Missing", "value")
+	require.Equal(t, createItemNotFoundError(ServiceTenantVariableService, OperationSetValue, "Missing"), err)
+
+	_, err = service.SetValue("Tenants-1", "Projects-2", "Environments-1", "Url", "value")
+	require.Equal(t, createItemNotFoundError(ServiceTenantVariableService, OperationSetValue, "Projects-2"), err)
+}
@@ -0,0 +1,67 @@
+package octopusdeploy
+
+// TenantVariables represents the values a tenant supplies for the variable
+// templates of its connected projects and included library variable sets.
+type TenantVariables struct {
+	LibraryVariables map[string]*TenantLibraryVariable `json:"LibraryVariables,omitempty"`
+	ProjectVariables map[string]*TenantProjectVariable `json:"ProjectVariables,omitempty"`
+	SpaceID          string                            `json:"SpaceId,omitempty"`
+	TenantID         string                            `json:"TenantId,omitempty"`
+	TenantName       string                            `json:"TenantName,omitempty"`
+
+	resource
+}
+
+// TenantProjectVariable represents the values a tenant supplies for the
+// variable templates of a project, keyed by environment ID and then by
+// template ID.
+type TenantProjectVariable struct {
+	Links       map[string]string                           `json:"Links,omitempty"`
+	ProjectID   string                                      `json:"ProjectId,omitempty"`
+	ProjectName string                                      `json:"ProjectName,omitempty"`
+	Templates   []*ActionTemplateParameter                  `json:"Templates,omitempty"`
+	Variables   map[string]map[string]PropertyValueResource `json:"Variables,omitempty"`
+}
+
+// TenantLibraryVariable represents the values a tenant supplies for the
+// variable templates of a library variable set, keyed by template ID.
+type TenantLibraryVariable struct {
+	LibraryVariableSetID   string                           `json:"LibraryVariableSetId,omitempty"`
+	LibraryVariableSetName string                           `json:"LibraryVariableSetName,omitempty"`
+	Links                  map[string]string                `json:"Links,omitempty"`
+	Templates              []*ActionTemplateParameter       `json:"Templates,omitempty"`
+	Variables              map[string]PropertyValueResource `json:"Variables,omitempty"`
+}
+
+// NewTenantVariables initializes tenant variables for a tenant.
+func NewTenantVariables(tenantID string) *TenantVariables {
+	return &TenantVariables{
+		LibraryVariables: map[string]*TenantLibraryVariable{},
+		ProjectVariables: map[string]*TenantProjectVariable{},
+		TenantID:         tenantID,
+		resource:         *newResource(),
+	}
+}
+
+// getTemplateByName returns the template with a matching name, or nil if one
+// cannot be found.
+func getTemplateByName(templates []*ActionTemplateParameter, name string) *ActionTemplateParameter {
+	for _, template := range templates {
+		if template.Name == name {
+			return template
+		}
+	}
+
+	return nil
+}
+
+// newTemplateValue returns the value of a template, wrapped as a sensitive
+// value when the template is sensitive.
+func newTemplateValue(template *ActionTemplateParameter, value string) PropertyValueResource {
+	if template.IsSensitive() {
+		return PropertyValueResource{SensitiveValue: NewSensitiveValue(value)}
+	}
+
+	propertyValue := PropertyValue(value)
+	return PropertyValueResource{PropertyValue: &propertyValue}
+}
@@ -0,0 +1,30 @@
+package octopusdeploy
+
+import "github.com/go-playground/validator/v10"
+
+// UpgradeConfiguration represents the settings the Octopus server uses to
+// check for and notify about new versions.
+type UpgradeConfiguration struct {
+	AllowChecking     bool                    `json:"AllowChecking"`
+	IncludeStatistics bool                    `json:"IncludeStatistics"`
+	NotificationMode  UpgradeNotificationMode `json:"NotificationMode,omitempty" validate:"omitempty,oneof=AlwaysShow NeverShow ShowOnlyMajorMinor"`
+
+	resource
+}
+
+// NewUpgradeConfiguration initializes upgrade settings with a notification
+// mode.
+func NewUpgradeConfiguration(notificationMode UpgradeNotificationMode) *UpgradeConfiguration {
+	return &UpgradeConfiguration{
+		AllowChecking:     true,
+		IncludeStatistics: true,
+		NotificationMode:  notificationMode,
+		resource:          *newResource(),
+	}
+}
+
+// Validate checks the state of the upgrade configuration and returns an error
+// if invalid.
+func (u *UpgradeConfiguration) Validate() error {
+	return validator.New().Struct(u)
+}
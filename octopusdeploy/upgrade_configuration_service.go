@@ -11,3 +11,38 @@ func newUpgradeConfigurationService(sling *sling.Sling, uriTemplate string) *upg
 		service: newService(ServiceUpgradeConfigurationService, sling, uriTemplate),
 	}
 }
+
+// Get returns the upgrade configuration of the Octopus server.
+func (s upgradeConfigurationService) Get() (*UpgradeConfiguration, error) {
+	path, err := getPath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiGet(s.getClient(), new(UpgradeConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*UpgradeConfiguration), nil
+}
+
+// Update modifies the upgrade configuration of the Octopus server based on the
+// one provided as input.
+func (s upgradeConfigurationService) Update(upgradeConfiguration *UpgradeConfiguration) (*UpgradeConfiguration, error) {
+	if upgradeConfiguration == nil {
+		return nil, createInvalidParameterError(OperationUpdate, ParameterUpgradeConfiguration)
+	}
+
+	path, err := getUpdatePath(s, upgradeConfiguration)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiUpdate(s.getClient(), upgradeConfiguration, new(UpgradeConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*UpgradeConfiguration), nil
+}
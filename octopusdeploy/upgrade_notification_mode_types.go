@@ -0,0 +1,9 @@
+package octopusdeploy
+
+type UpgradeNotificationMode string
+
+const (
+	UpgradeNotificationModeAlwaysShow         = UpgradeNotificationMode("AlwaysShow")
+	UpgradeNotificationModeNeverShow          = UpgradeNotificationMode("NeverShow")
+	UpgradeNotificationModeShowOnlyMajorMinor = UpgradeNotificationMode("ShowOnlyMajorMinor")
+)
@@ -0,0 +1,354 @@
+package octopusdeploy
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// UserImportRecord describes a user to import.
+type UserImportRecord struct {
+	DisplayName  string     `json:"DisplayName,omitempty"`
+	EmailAddress string     `json:"EmailAddress,omitempty"`
+	Identities   []Identity `json:"Identities,omitempty"`
+	IsService    bool       `json:"IsService,omitempty"`
+	Teams        []string   `json:"Teams,omitempty"`
+	Username     string     `json:"Username"`
+}
+
+// UserImportResult reports the outcome of importing users.
+type UserImportResult struct {
+	Created []*UserImportResultItem `json:"Created"`
+	Failed  []*UserImportResultItem `json:"Failed"`
+	Skipped []*UserImportResultItem `json:"Skipped"`
+	Updated []*UserImportResultItem `json:"Updated"`
+}
+
+// UserImportResultItem reports the outcome of importing a single record.
+type UserImportResultItem struct {
+	Changes  []string `json:"Changes,omitempty"`
+	Reason   string   `json:"Reason,omitempty"`
+	UserID   string   `json:"UserId,omitempty"`
+	Username string   `json:"Username"`
+}
+
+// userImportColumns are the CSV columns read by ParseUserImportCSV.
+const (
+	userImportColumnClaims           = "claims"
+	userImportColumnDisplayName      = "displayname"
+	userImportColumnEmailAddress     = "emailaddress"
+	userImportColumnIdentityProvider = "identityprovider"
+	userImportColumnIsService        = "isservice"
+	userImportColumnTeams            = "teams"
+	userImportColumnUsername         = "username"
+)
+
+// ParseUserImportCSV reads user import records from CSV. The first row is a
+// header naming the columns Username (required), DisplayName, EmailAddress,
+// IsService, IdentityProvider, Claims and Teams, in any order and without
+// regard to case. Claims are written as name=value pairs and teams as names,
+// each separated by semicolons; every claim is marked as identifying. Rows
+// that repeat a username add identities and teams to the earlier record.
+func ParseUserImportCSV(r io.Reader) ([]*UserImportRecord, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	if _, ok := columns[userImportColumnUsername]; !ok {
+		return nil, fmt.Errorf("the user import header has no %s column", userImportColumnUsername)
+	}
+
+	records := []*UserImportRecord{}
+	recordsByUsername := map[string]*UserImportRecord{}
+
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		field := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return empty
+		}
+
+		username := field(userImportColumnUsername)
+		if isEmpty(username) {
+			return nil, fmt.Errorf("line %d of the user import has no username", line)
+		}
+
+		record, ok := recordsByUsername[strings.ToLower(username)]
+		if !ok {
+			record = &UserImportRecord{Username: username}
+			recordsByUsername[strings.ToLower(username)] = record
+			records = append(records, record)
+		}
+
+		if displayName := field(userImportColumnDisplayName); !isEmpty(displayName) {
+			record.DisplayName = displayName
+		}
+
+		if emailAddress := field(userImportColumnEmailAddress); !isEmpty(emailAddress) {
+			record.EmailAddress = emailAddress
+		}
+
+		if isService := field(userImportColumnIsService); !isEmpty(isService) {
+			record.IsService = strings.EqualFold(isService, "true")
+		}
+
+		if identityProvider := field(userImportColumnIdentityProvider); !isEmpty(identityProvider) {
+			identity := Identity{
+				Claims:               map[string]IdentityClaim{},
+				IdentityProviderName: identityProvider,
+			}
+
+			for _, claim := range splitUserImportList(field(userImportColumnClaims)) {
+				parts := strings.SplitN(claim, "=", 2)
+				if len(parts) != 2 || isEmpty(strings.TrimSpace(parts[0])) {
+					return nil, fmt.Errorf("line %d of the user import has an invalid claim (%s)", line, claim)
+				}
+				identity.Claims[strings.TrimSpace(parts[0])] = IdentityClaim{
+					IsIdentifyingClaim: true,
+					Value:              strings.TrimSpace(parts[1]),
+				}
+			}
+
+			record.Identities = append(record.Identities, identity)
+		}
+
+		record.Teams = append(record.Teams, splitUserImportList(field(userImportColumnTeams))...)
+	}
+
+	return records, nil
+}
+
+func splitUserImportList(value string) []string {
+	items := []string{}
+	for _, item := range strings.Split(value, ";") {
+		if item = strings.TrimSpace(item); !isEmpty(item) {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// ParseUserImportJSON reads user import records from a JSON array.
+func ParseUserImportJSON(r io.Reader) ([]*UserImportRecord, error) {
+	records := []*UserImportRecord{}
+	if err := json.NewDecoder(r).Decode(&records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// WriteJSON writes the import result to the input writer as indented JSON.
+func (r *UserImportResult) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent(empty, tab)
+	return encoder.Encode(r)
+}
+
+// ImportUsers creates or updates a user for each input record and adds it to
+// the record's teams. Users are matched by username without regard to case.
+// Existing users are updated only where a record sets a different display
+// name or email address, or adds an identity provider or claim; existing
+// values that are missing from a record are kept. Records that would not
+// change anything are skipped. A record fails without any change if it has
+// no username or names a team that does not exist. Team memberships are
+// saved once all records have been processed.
+func (c *Client) ImportUsers(records []*UserImportRecord) (*UserImportResult, error) {
+	result := &UserImportResult{
+		Created: []*UserImportResultItem{},
+		Failed:  []*UserImportResultItem{},
+		Skipped: []*UserImportResultItem{},
+		Updated: []*UserImportResultItem{},
+	}
+
+	users, err := c.Users.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	usersByUsername := map[string]*User{}
+	for _, user := range users {
+		usersByUsername[strings.ToLower(user.Username)] = user
+	}
+
+	teams, err := c.Teams.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	teamsByName := map[string]*Team{}
+	for _, team := range teams {
+		teamsByName[strings.ToLower(team.Name)] = team
+	}
+
+	changedTeams := map[string]*Team{}
+
+	for _, record := range records {
+		if record == nil {
+			continue
+		}
+
+		item := &UserImportResultItem{
+			Changes:  []string{},
+			Username: record.Username,
+		}
+
+		if isEmpty(strings.TrimSpace(record.Username)) {
+			item.Reason = "the username is required"
+			result.Failed = append(result.Failed, item)
+			continue
+		}
+
+		recordTeams := []*Team{}
+		for _, teamName := range record.Teams {
+			team, ok := teamsByName[strings.ToLower(teamName)]
+			if !ok {
+				item.Reason = fmt.Sprintf("the team (%s) was not found", teamName)
+				break
+			}
+			recordTeams = append(recordTeams, team)
+		}
+		if !isEmpty(item.Reason) {
+			result.Failed = append(result.Failed, item)
+			continue
+		}
+
+		user, exists := usersByUsername[strings.ToLower(record.Username)]
+		if !exists {
+			newUser := NewUser(record.Username, record.DisplayName)
+			if isEmpty(newUser.DisplayName) {
+				newUser.DisplayName = record.Username
+			}
+			newUser.EmailAddress = record.EmailAddress
+			newUser.Identities = record.Identities
+			newUser.IsActive = true
+			newUser.IsService = record.IsService
+
+			user, err = c.Users.Add(newUser)
+			if err != nil {
+				item.Reason = err.Error()
+				result.Failed = append(result.Failed, item)
+				continue
+			}
+			usersByUsername[strings.ToLower(record.Username)] = user
+		} else if changes := applyUserImportRecord(user, record); len(changes) > 0 {
+			updatedUser, err := c.Users.Update(user)
+			if err != nil {
+				item.Reason = err.Error()
+				result.Failed = append(result.Failed, item)
+				continue
+			}
+			user = updatedUser
+			usersByUsername[strings.ToLower(record.Username)] = user
+			item.Changes = append(item.Changes, changes...)
+		}
+		item.UserID = user.GetID()
+
+		for _, team := range recordTeams {
+			if ValidateStringInSlice(user.GetID(), team.MemberUserIDs) {
+				continue
+			}
+			team.MemberUserIDs = append(team.MemberUserIDs, user.GetID())
+			changedTeams[team.GetID()] = team
+			item.Changes = append(item.Changes, fmt.Sprintf("joined team %s", team.Name))
+		}
+
+		switch {
+		case !exists:
+			result.Created = append(result.Created, item)
+		case len(item.Changes) > 0:
+			result.Updated = append(result.Updated, item)
+		default:
+			result.Skipped = append(result.Skipped, item)
+		}
+	}
+
+	teamIDs := []string{}
+	for teamID := range changedTeams {
+		teamIDs = append(teamIDs, teamID)
+	}
+	sort.Strings(teamIDs)
+
+	for _, teamID := range teamIDs {
+		if _, err := c.Teams.Update(changedTeams[teamID]); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// applyUserImportRecord copies the values set by the record onto the user and
+// returns a description of each change.
+func applyUserImportRecord(user *User, record *UserImportRecord) []string {
+	changes := []string{}
+
+	if !isEmpty(record.DisplayName) && user.DisplayName != record.DisplayName {
+		user.DisplayName = record.DisplayName
+		changes = append(changes, "display name")
+	}
+
+	if !isEmpty(record.EmailAddress) && user.EmailAddress != record.EmailAddress {
+		user.EmailAddress = record.EmailAddress
+		changes = append(changes, "email address")
+	}
+
+	for _, identity := range record.Identities {
+		index := -1
+		for i := range user.Identities {
+			if strings.EqualFold(user.Identities[i].IdentityProviderName, identity.IdentityProviderName) {
+				index = i
+				break
+			}
+		}
+
+		if index < 0 {
+			user.Identities = append(user.Identities, identity)
+			changes = append(changes, fmt.Sprintf("identity %s", identity.IdentityProviderName))
+			continue
+		}
+
+		existing := &user.Identities[index]
+		if existing.Claims == nil {
+			existing.Claims = map[string]IdentityClaim{}
+		}
+
+		claimNames := []string{}
+		for claimName := range identity.Claims {
+			claimNames = append(claimNames, claimName)
+		}
+		sort.Strings(claimNames)
+
+		for _, claimName := range claimNames {
+			claim := identity.Claims[claimName]
+			if existingClaim, ok := existing.Claims[claimName]; ok && existingClaim == claim {
+				continue
+			}
+			existing.Claims[claimName] = claim
+			changes = append(changes, fmt.Sprintf("identity %s claim %s", identity.IdentityProviderName, claimName))
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,122 @@
+package octopusdeploy
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUserImportCSV(t *testing.T) {
+	input := `Username,DisplayName,EmailAddress,IdentityProvider,Claims,Teams
+alice,Alice Smith,alice@example.com,Azure AD,email=alice@example.com;upn=alice@example.com,Deployers;Operators
+ALICE,,,Active Directory,upn=EXAMPLE\alice,
+bob,Bob Jones,,,,`
+
+	records, err := ParseUserImportCSV(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	alice := records[0]
+	require.Equal(t, "alice", alice.Username)
+	require.Equal(t, "Alice Smith", alice.DisplayName)
+	require.Equal(t, []string{"Deployers", "Operators"}, alice.Teams)
+	require.Len(t, alice.Identities, 2)
+	require.Equal(t, "Azure AD", alice.Identities[0].IdentityProviderName)
+	require.Equal(t, IdentityClaim{IsIdentifyingClaim: true, Value: "alice@example.com"}, alice.Identities[0].Claims["upn"])
+	require.Equal(t, `EXAMPLE\alice`, alice.Identities[1].Claims["upn"].Value)
+
+	require.Equal(t, "bob", records[1].Username)
+	require.Empty(t, records[1].Identities)
+
+	_, err = ParseUserImportCSV(strings.NewReader("DisplayName\nAlice"))
+	require.Error(t, err)
+
+	_, err = ParseUserImportCSV(strings.NewReader("Username,IdentityProvider,Claims\nalice,Azure AD,email"))
+	require.Error(t, err)
+}
+
+func TestParseUserImportJSON(t *testing.T) {
+	input := `[{"Username":"alice","Teams":["Deployers"],"Identities":[{"IdentityProviderName":"Azure AD","Claims":{"email":{"IsIdentifyingClaim":true,"Value":"alice@example.com"}}}]}]`
+
+	records, err := ParseUserImportJSON(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, []string{"Deployers"}, records[0].Teams)
+	require.Equal(t, "alice@example.com", records[0].Identities[0].Claims["email"].Value)
+}
+
+func TestImportUsers(t *testing.T) {
+	directory := newFakeUserDirectory(t)
+	directory.users = []*User{
+		{DisplayName: "Alice", Username: "alice", resource: resource{ID: "Users-1"}},
+		{DisplayName: "Bob Jones", Username: "bob", resource: resource{ID: "Users-2"}},
+	}
+	directory.teams[0].MemberUserIDs = []string{"Users-2"}
+	client := directory.client()
+
+	records := []*UserImportRecord{
+		{DisplayName: "Alice Smith", Teams: []string{"Operators"}, Username: "Alice"},
+		{DisplayName: "Bob Jones", Teams: []string{"Deployers"}, Username: "bob"},
+		{DisplayName: "Carol", EmailAddress: "carol@example.com", Teams: []string{"Deployers", "Operators"}, Username: "carol"},
+		{Teams: []string{"Missing"}, Username: "dave"},
+		{Username: " "},
+	}
+
+	result, err := client.ImportUsers(records)
+	require.NoError(t, err)
+
+	require.Len(t, result.Created, 1)
+	require.Equal(t, "carol", result.Created[0].Username)
+	require.Equal(t, "Users-3", result.Created[0].UserID)
+	require.Equal(t, "carol@example.com", directory.users[2].EmailAddress)
+
+	require.Len(t, result.Updated, 1)
+	require.Equal(t, "Users-1", result.Updated[0].UserID)
+	require.Equal(t, []string{"display name", "joined team Operators"}, result.Updated[0].Changes)
+	require.Equal(t, []string{"Users-1"}, directory.updatedUsers)
+
+	require.Len(t, result.Skipped, 1)
+	require.Equal(t, "bob", result.Skipped[0].Username)
+
+	require.Len(t, result.Failed, 2)
+	require.Equal(t, "the team (Missing) was not found", result.Failed[0].Reason)
+	require.Equal(t, "the username is required", result.Failed[1].Reason)
+
+	// each team is saved once
+	require.Equal(t, []string{"Teams-1", "Teams-3"}, directory.updatedTeams)
+	require.Equal(t, []string{"Users-2", "Users-3"}, directory.teams[0].MemberUserIDs)
+	require.Equal(t, []string{"Users-1", "Users-3"}, directory.teams[2].MemberUserIDs)
+
+	var buffer bytes.Buffer
+	require.NoError(t, result.WriteJSON(&buffer))
+	require.Contains(t, buffer.String(), `"Username": "carol"`)
+}
+
+func TestApplyUserImportRecord(t *testing.T) {
+	user := &User{
+		Identities: []Identity{
+			{
+				Claims:               map[string]IdentityClaim{"email": {IsIdentifyingClaim: true, Value: "alice@example.com"}},
+				IdentityProviderName: "Azure AD",
+			},
+		},
+	}
+
+	record := &UserImportRecord{
+		Identities: []Identity{
+			{
+				Claims:               map[string]IdentityClaim{"email": {IsIdentifyingClaim: true, Value: "alice@example.com"}},
+				IdentityProviderName: "azure ad",
+			},
+		},
+	}
+	require.Empty(t, applyUserImportRecord(user, record))
+
+	record.Identities[0].Claims["upn"] = IdentityClaim{Value: "alice"}
+	record.Identities = append(record.Identities, Identity{IdentityProviderName: "Okta"})
+	require.Equal(t, []string{"identity azure ad claim upn", "identity Okta"}, applyUserImportRecord(user, record))
+	require.Len(t, user.Identities, 2)
+	require.Equal(t, "alice", user.Identities[0].Claims["upn"].Value)
+}
@@ -0,0 +1,40 @@
+package octopusdeploy
+
+import "github.com/go-playground/validator/v10"
+
+// UserRoles defines a collection of user roles with built-in support for
+// paged results.
+type UserRoles struct {
+	Items []*UserRole `json:"Items"`
+	PagedResults
+}
+
+// UserRole represents a named set of space and system permissions that can be
+// granted to a team.
+type UserRole struct {
+	CanBeDeleted                 bool     `json:"CanBeDeleted,omitempty"`
+	Description                  string   `json:"Description,omitempty"`
+	GrantedSpacePermissions      []string `json:"GrantedSpacePermissions"`
+	GrantedSystemPermissions     []string `json:"GrantedSystemPermissions"`
+	Name                         string   `json:"Name" validate:"required"`
+	SpacePermissionDescriptions  []string `json:"SpacePermissionDescriptions,omitempty"`
+	SupportedRestrictions        []string `json:"SupportedRestrictions,omitempty"`
+	SystemPermissionDescriptions []string `json:"SystemPermissionDescriptions,omitempty"`
+
+	resource
+}
+
+// NewUserRole initializes a user role with a name.
+func NewUserRole(name string) *UserRole {
+	return &UserRole{
+		GrantedSpacePermissions:  []string{},
+		GrantedSystemPermissions: []string{},
+		Name:                     name,
+		resource:                 *newResource(),
+	}
+}
+
+// Validate checks the state of the user role and returns an error if invalid.
+func (u *UserRole) Validate() error {
+	return validator.New().Struct(u)
+}
@@ -1,6 +1,9 @@
 package octopusdeploy
 
-import "github.com/dghubble/sling"
+import (
+	"github.com/dghubble/sling"
+	"github.com/google/go-querystring/query"
+)
 
 type userRoleService struct {
 	canDeleteService
@@ -12,3 +15,133 @@ func newUserRoleService(sling *sling.Sling, uriTemplate string) *userRoleService
 
 	return userRoleService
 }
+
+func (s userRoleService) getPagedResponse(path string) ([]*UserRole, error) {
+	resources := []*UserRole{}
+	loadNextPage := true
+
+	for loadNextPage {
+		resp, err := apiGet(s.getClient(), new(UserRoles), path)
+		if err != nil {
+			return resources, err
+		}
+
+		responseList := resp.(*UserRoles)
+		resources = append(resources, responseList.Items...)
+		path, loadNextPage = LoadNextPage(responseList.PagedResults)
+	}
+
+	return resources, nil
+}
+
+// Add creates a new user role.
+func (s userRoleService) Add(userRole *UserRole) (*UserRole, error) {
+	if userRole == nil {
+		return nil, createInvalidParameterError(OperationAdd, ParameterUserRole)
+	}
+
+	path, err := getAddPath(s, userRole)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiAdd(s.getClient(), userRole, new(UserRole), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*UserRole), nil
+}
+
+// Delete will delete a user role if it is not a built-in user role (i.e. the
+// field, CanBeDeleted is true). If the user role cannot be deleted or an error
+// occurs, it returns an error.
+func (s userRoleService) Delete(userRole *UserRole) error {
+	if userRole == nil {
+		return createInvalidParameterError(OperationDelete, ParameterUserRole)
+	}
+
+	if !userRole.CanBeDeleted {
+		return createBuiltInUserRolesCannotDeleteError()
+	}
+
+	return s.DeleteByID(userRole.GetID())
+}
+
+// Get returns a collection of user roles based on the criteria defined by its
+// input query parameter. If an error occurs, an empty collection is returned
+// along with the associated error.
+func (s userRoleService) Get(userRolesQuery UserRolesQuery) (*UserRoles, error) {
+	v, _ := query.Values(userRolesQuery)
+	path := s.BasePath
+	encodedQueryString := v.Encode()
+	if len(encodedQueryString) > 0 {
+		path += "?" + encodedQueryString
+	}
+
+	resp, err := apiGet(s.getClient(), new(UserRoles), path)
+	if err != nil {
+		return &UserRoles{}, err
+	}
+
+	return resp.(*UserRoles), nil
+}
+
+// GetAll returns all user roles. If none can be found or an error occurs, it
+// returns an empty collection.
+func (s userRoleService) GetAll() ([]*UserRole, error) {
+	items := []*UserRole{}
+	path, err := getAllPath(s)
+	if err != nil {
+		return items, err
+	}
+
+	_, err = apiGet(s.getClient(), &items, path)
+	return items, err
+}
+
+// GetByID returns the user role that matches the input ID. If one cannot be
+// found, it returns nil and an error.
+func (s userRoleService) GetByID(id string) (*UserRole, error) {
+	path, err := getByIDPath(s, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiGet(s.getClient(), new(UserRole), path)
+	if err != nil {
+		return nil, createResourceNotFoundError(s.getName(), "ID", id)
+	}
+
+	return resp.(*UserRole), nil
+}
+
+// GetByPartialName performs a lookup and returns user roles with a matching
+// partial name.
+func (s userRoleService) GetByPartialName(name string) ([]*UserRole, error) {
+	path, err := getByPartialNamePath(s, name)
+	if err != nil {
+		return []*UserRole{}, err
+	}
+
+	return s.getPagedResponse(path)
+}
+
+// Update modifies a user role based on the one provided as input.
+func (s userRoleService) Update(userRole *UserRole) (*UserRole, error) {
+	if userRole == nil {
+		return nil, createInvalidParameterError(OperationUpdate, ParameterUserRole)
+	}
+
+	path, err := getUpdatePath(s, userRole)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiUpdate(s.getClient(), userRole, new(UserRole), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*UserRole), nil
+}
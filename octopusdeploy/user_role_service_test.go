@@ -0,0 +1,80 @@
+package octopusdeploy
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/dghubble/sling"
+	"github.com/stretchr/testify/require"
+)
+
+func createUserRoleService(t *testing.T) *userRoleService {
+	service := newUserRoleService(nil, TestURIUserRoles)
+	testNewService(t, service, TestURIUserRoles, ServiceUserRoleService)
+	return service
+}
+
+func TestUserRoleServiceNew(t *testing.T) {
+	ServiceFunction := newUserRoleService
+	client := &sling.Sling{}
+	uriTemplate := emptyString
+	ServiceName := ServiceUserRoleService
+
+	testCases := []struct {
+		name        string
+		f           func(*sling.Sling, string) *userRoleService
+		client      *sling.Sling
+		uriTemplate string
+	}{
+		{"NilClient", ServiceFunction, nil, uriTemplate},
+		{"EmptyURITemplate", ServiceFunction, client, emptyString},
+		{"URITemplateWithWhitespace", ServiceFunction, client, whitespaceString},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := tc.f(tc.client, tc.uriTemplate)
+			testNewService(t, service, uriTemplate, ServiceName)
+		})
+	}
+}
+
+func TestUserRoleServiceParameters(t *testing.T) {
+	service := createUserRoleService(t)
+	require.NotNil(t, service)
+
+	resource, err := service.Add(nil)
+	require.Equal(t, createInvalidParameterError(OperationAdd, ParameterUserRole), err)
+	require.Nil(t, resource)
+
+	resource, err = service.Add(&UserRole{})
+	require.Error(t, err)
+	require.Nil(t, resource)
+
+	resource, err = service.GetByID(emptyString)
+	require.Equal(t, createInvalidParameterError(OperationGetByID, ParameterID), err)
+	require.Nil(t, resource)
+
+	resource, err = service.Update(nil)
+	require.Equal(t, createInvalidParameterError(OperationUpdate, ParameterUserRole), err)
+	require.Nil(t, resource)
+
+	err = service.Delete(nil)
+	require.Equal(t, createInvalidParameterError(OperationDelete, ParameterUserRole), err)
+
+	err = service.Delete(NewUserRole("System Administrator"))
+	require.Equal(t, createBuiltInUserRolesCannotDeleteError(), err)
+}
+
+func TestPermissionServiceGetAll(t *testing.T) {
+	client := createFakeSling(func(r *http.Request) (int, string) {
+		require.Equal(t, TestURIPermissionDescriptions, r.URL.Path)
+		return http.StatusOK, `{"DeploymentCreate":{"Description":"Deploy releases to target environments","SupportedRestrictions":["restrictable-to-projects","restrictable-to-environments","restrictable-to-tenants"],"CanApplyAtSpaceLevel":true,"CanApplyAtSystemLevel":false}}`
+	})
+	service := newPermissionService(client, TestURIPermissionDescriptions)
+
+	permissions, err := service.GetAll()
+	require.NoError(t, err)
+	require.Len(t, permissions, 1)
+	require.True(t, permissions["DeploymentCreate"].SupportsRestriction(PermissionRestrictionEnvironments))
+	require.False(t, permissions["DeploymentCreate"].SupportsRestriction(PermissionRestrictionProjectGroups))
+}
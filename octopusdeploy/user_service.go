@@ -2,6 +2,7 @@ package octopusdeploy
 
 import (
 	"github.com/dghubble/sling"
+	"github.com/fqjony/go-octopusdeploy/uritemplates"
 	"github.com/google/go-querystring/query"
 )
 
@@ -217,7 +218,11 @@ func (s userService) GetPermissions(user *User, userQuery ...UserQuery) (*UserPe
 	}
 
 	response, err := apiGet(s.getClient(), new(UserPermissionSet), path)
-	return response.(*UserPermissionSet), err
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*UserPermissionSet), nil
 }
 
 func (s userService) GetPermissionsConfiguration(user *User, userQuery ...UserQuery) (*UserPermissionSet, error) {
@@ -233,7 +238,11 @@ func (s userService) GetPermissionsConfiguration(user *User, userQuery ...UserQu
 	}
 
 	response, err := apiGet(s.getClient(), new(UserPermissionSet), path)
-	return response.(*UserPermissionSet), err
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*UserPermissionSet), nil
 }
 
 func (s userService) GetSpaces(user *User) ([]*Space, error) {
@@ -269,6 +278,42 @@ func (s userService) GetTeams(user *User, userQuery ...UserQuery) (*[]ProjectedT
 	return response.(*[]ProjectedTeamReferenceDataItem), nil
 }
 
+// SearchExternalUsers searches the identity providers for users with a
+// matching partial name.
+func (s userService) SearchExternalUsers(partialName string) (*ExternalUserSearchResults, error) {
+	if isEmpty(partialName) {
+		return nil, createInvalidParameterError(OperationSearchExternalUsers, ParameterPartialName)
+	}
+
+	if err := validateInternalState(s); err != nil {
+		return nil, err
+	}
+
+	if isEmpty(s.externalUserSearchPath) {
+		return nil, createInvalidPathError(s.getName())
+	}
+
+	template, err := uritemplates.Parse(s.externalUserSearchPath)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]interface{})
+	values[ParameterPartialName] = partialName
+
+	path, err := template.Expand(values)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := apiGet(s.getClient(), new(ExternalUserSearchResults), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*ExternalUserSearchResults), nil
+}
+
 // Update modifies a user based on the one provided as input.
 func (s userService) Update(user *User) (*User, error) {
 	if user == nil {
@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/dghubble/sling"
 )
@@ -114,6 +115,12 @@ func isNil(i interface{}) bool {
 	case *Runbook:
 		v := i.(*Runbook)
 		ret = v == nil
+	case *RunbookRun:
+		v := i.(*RunbookRun)
+		ret = v == nil
+	case *ScopedUserRole:
+		v := i.(*ScopedUserRole)
+		ret = v == nil
 	case *Space:
 		v := i.(*Space)
 		ret = v == nil
@@ -129,6 +136,9 @@ func isNil(i interface{}) bool {
 	case *User:
 		v := i.(*User)
 		ret = v == nil
+	case *UserRole:
+		v := i.(*UserRole)
+		ret = v == nil
 	}
 
 	return ret
@@ -183,6 +193,10 @@ func createBuiltInTeamsCannotDeleteError() error {
 	return fmt.Errorf("The built-in teams cannot be deleted.")
 }
 
+func createBuiltInUserRolesCannotDeleteError() error {
+	return fmt.Errorf("The built-in user roles cannot be deleted.")
+}
+
 func createInvalidParameterError(methodName string, ParameterName string) error {
 	return fmt.Errorf("%s: the input parameter (%s) is invalid", methodName, ParameterName)
 }
@@ -211,6 +225,14 @@ func createResourceNotFoundError(name string, identifier string, value string) e
 	return fmt.Errorf("the service, %s could not find the %s (%s)", name, identifier, value)
 }
 
+func createTimeoutError(methodName string, timeout time.Duration) error {
+	return fmt.Errorf("%s: the operation did not complete within %s", methodName, timeout)
+}
+
+func createUserIsNotServiceAccountError(methodName string, username string) error {
+	return fmt.Errorf("%s: the user (%s) exists but is not a service account", methodName, username)
+}
+
 func createValidationFailureError(methodName string, err error) error {
 	return fmt.Errorf("validation failure in %s; %v", methodName, err)
 }
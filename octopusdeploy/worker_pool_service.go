@@ -112,9 +112,12 @@ func (s workerPoolService) Add(workerPool IWorkerPool) (IWorkerPool, error) {
 // returns an empty collection.
 func (s *workerPoolService) GetAll() ([]IWorkerPool, error) {
 	items := []*WorkerPoolResource{}
-	path := s.BasePath + "/all"
+	path, err := getAllPath(s)
+	if err != nil {
+		return toWorkerPoolArray(items), err
+	}
 
-	_, err := apiGet(s.getClient(), &items, path)
+	_, err = apiGet(s.getClient(), &items, path)
 	return toWorkerPoolArray(items), err
 }
 